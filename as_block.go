@@ -0,0 +1,56 @@
+package rpsl
+
+import "errors"
+
+// ASBlock is an RPSL 'as-block class' object. An as-block delegates a range of ASNs to a registry
+// or organization, and controls the creation of aut-num objects within the range.
+type ASBlock struct {
+	// Range of ASNs, e.g. AS64496 - AS64511.
+	//    *Required
+	ASBlock ASNRange `rpsl:"as-block,mandatory"`
+	// Description for the as-block object.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the as-block object itself. Multiple maintainers are
+	// separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which authorizes the creation of aut-num objects within the range.
+	// Multiple maintainers are separated by newlines.
+	MntLower string `rpsl:"mnt-lower,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the as-block object.
+func (b *ASBlock) AddExtra(key, value string) {
+	b.Extra.Add(key, value)
+}
+
+// String representation of the as-block in RPSL format. E.g. AS64496 - AS64511.
+func (b *ASBlock) String() string {
+	return b.ASBlock.String()
+}
+
+// Contains determines if an aut-num belongs to the as-block.
+func (b *ASBlock) Contains(a *AutNum) bool {
+	return b.ASBlock.Contains(a.AutNum)
+}
+
+// Validate validates the as-block object, returning all problems found. The first ASN of the range
+// must not be greater than the last.
+func (b *ASBlock) Validate() error {
+	errs := validateMandatory(b)
+	if b.ASBlock.First > b.ASBlock.Last {
+		errs = append(errs, &ValidationError{Attribute: "as-block", Value: b.ASBlock.String(), Err: ErrInvalidRange})
+	}
+	errs = append(errs, validateContacts(b.AdminPOC, b.TechPOC, b.MntBy)...)
+	errs = append(errs, validateNames("mnt-lower", b.MntLower)...)
+	return errors.Join(errs...)
+}
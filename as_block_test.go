@@ -0,0 +1,82 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestASBlock_RPSL(t *testing.T) {
+	t.Parallel()
+	b := rpsl.ASBlock{
+		ASBlock:     rpsl.MustParseASNRange("AS64496 - AS64511"),
+		Description: "Documentation ASNs",
+		AdminPOC:    "TEST-ADMIN",
+		TechPOC:     "TEST-TECH",
+		MntBy:       "MNT-TEST",
+		MntLower:    "MNT-LOWER",
+		Source:      "RIPE",
+	}
+	exp := []byte(`as-block: AS64496 - AS64511
+descr: Documentation ASNs
+admin-c: TEST-ADMIN
+tech-c: TEST-TECH
+mnt-by: MNT-TEST
+mnt-lower: MNT-LOWER
+source: RIPE`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&b)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "AS64496 - AS64511", b.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.ASBlock
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, b, decoded)
+	})
+	t.Run("decode asdot", func(t *testing.T) {
+		var decoded rpsl.ASBlock
+		err := rpsl.UnmarshalBinary([]byte("as-block: AS1.0 - AS1.15"), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, rpsl.ASNRange{First: 65536, Last: 65551}, decoded.ASBlock)
+	})
+	t.Run("err decode", func(t *testing.T) {
+		var decoded rpsl.ASBlock
+		err := rpsl.UnmarshalBinary([]byte("as-block: AS64511 - AS64496"), &decoded)
+		assert.ErrorIs(t, err, rpsl.ErrInvalidRange)
+	})
+	t.Run("contains", func(t *testing.T) {
+		assert.True(t, b.Contains(&rpsl.AutNum{AutNum: 64500}))
+		assert.False(t, b.Contains(&rpsl.AutNum{AutNum: 65000}))
+	})
+}
+
+func TestASBlock_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		block rpsl.ASBlock
+		err   error
+	}{
+		{"valid", rpsl.ASBlock{ASBlock: rpsl.MustParseASNRange("AS64496 - AS64511"), MntLower: "MNT-ACME"}, nil},
+		{"missing as-block", rpsl.ASBlock{MntBy: "MNT-ACME"}, rpsl.ErrMissingAttribute},
+		{"inverted range", rpsl.ASBlock{ASBlock: rpsl.ASNRange{First: 64511, Last: 64496}}, rpsl.ErrInvalidRange},
+		{"invalid mnt-lower", rpsl.ASBlock{ASBlock: rpsl.MustParseASNRange("AS64496 - AS64511"), MntLower: "MNT LOWER"}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.block.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -1,21 +1,16 @@
 package rpsl
 
 import (
+	"errors"
 	"regexp"
 )
 
-var startsWithASDash = regexp.MustCompile(`^[AaSs]{2}\-.*$`)
-var startsWithAS = regexp.MustCompile(`^[AaSs]{2}\d+$`)
 var bareAS = regexp.MustCompile(`^\d+$`)
 
-// ASSetName creates an as-set member type, e.g. AS-ACME or AS65000.
+// ASSetName creates an as-set name, e.g. AS-ACME, AS-65000, or AS65000:AS-CUSTOMERS. See
+// rpsl.NormalizeSetName.
 func ASSetName(name string) string {
-	if startsWithASDash.MatchString(name) {
-		name = name[3:]
-	} else if startsWithAS.MatchString(name) {
-		name = name[2:]
-	}
-	return "AS-" + name
+	return NormalizeSetName(name, ASSetPrefix)
 }
 
 // ASSetMembers creates a list of as-set members for use by [rpsl.ASSet].
@@ -24,7 +19,7 @@ func ASSetMembers(vals ...any) []string {
 	for _, v := range vals {
 		switch t := v.(type) {
 		case string:
-			if startsWithASDash.MatchString(t) || startsWithAS.MatchString(t) {
+			if isASNName(t) || hasSetComponent(t, ASSetPrefix) {
 				out = append(out, t)
 				continue
 			}
@@ -71,40 +66,62 @@ func ASSetMembers(vals ...any) []string {
 type ASSet struct {
 	// Name of the as-set object.
 	//    *Required
-	ASSet string `rpsl:"as-set"`
+	ASSet string `rpsl:"as-set,mandatory"`
 	// Description for the as-set object.
-	Description string `rpsl:"descr,omitempty" as:"multiline"`
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
 	// Admin Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	AdminPOC string `rpsl:"admin-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
 	// Technical Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	TechPOC string `rpsl:"tech-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
 	// Maintainer object, the prefix MNT and the Org ID of the organization that configures
 	// (maintains) the IRR object and manages the resource that is specified in the route object.
-	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP.
-	MntBy string `rpsl:"mnt-by,omitempty"`
+	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP. Multiple maintainers are
+	// separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
 	// Any additional information the creator of the objects wants to provide.
-	Remarks string `rpsl:"remarks,omitempty"`
-	// Members of the set; ASNs, aut-num object names, or other as-set names are accepted.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Members of the set; ASNs, aut-num object names, or other as-set names are accepted. Decoded
+	// attributes listing several comma-separated members are split into one member each.
 	//
 	// Use rpsl.ASSetMembers, rpsl.ASNName, and rpsl.ASSetName functions to ensure proper formatting.
-	Members []string `rpsl:"members,omitempty" as:"multiline"`
-	// Private container for extra attributes
-	Extra map[string]string `rpsl:"-"`
+	Members []string `rpsl:"members,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
 	// Registry Source. Most registries require this field.
 	Source string `rpsl:"source,omitempty"`
 }
 
 // Add extra pre-formatted attributes to the as-set object.
 func (a *ASSet) AddExtra(key, value string) {
-	if a.Extra == nil {
-		a.Extra = make(map[string]string)
-	}
-	a.Extra[key] = value
+	a.Extra.Add(key, value)
 }
 
-// String representation of the as-set in RPSL format. E.g. AS-ACME.
+// String representation of the as-set in RPSL format. E.g. AS-ACME or AS65000:AS-CUSTOMERS.
 func (a *ASSet) String() string {
+	if n, err := ParseSetName(a.ASSet, ASSetPrefix); err == nil {
+		return n.String()
+	}
 	return ASSetName(a.ASSet)
 }
+
+// Validate validates the as-set object, returning all problems found. The as-set name must comply
+// with RFC 2622 section 5, and members must be ASNs or as-set names.
+func (a *ASSet) Validate() error {
+	errs := validateMandatory(a)
+	if a.ASSet != "" {
+		if err := validateSetName("as-set", a.ASSet, "AS-"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, m := range a.Members {
+		if !isASNName(m) {
+			if err := validateSetName("members", m, "AS-"); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	errs = append(errs, validateContacts(a.AdminPOC, a.TechPOC, a.MntBy)...)
+	return errors.Join(errs...)
+}
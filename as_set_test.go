@@ -25,10 +25,14 @@ members: AS-65001`)
 	t.Run("string", func(t *testing.T) {
 		assert.Equal(t, "AS-ACME", asSet.String())
 	})
+	t.Run("string hierarchical", func(t *testing.T) {
+		hierarchical := rpsl.ASSet{ASSet: "AS65000:AS-CUST"}
+		assert.Equal(t, "AS65000:AS-CUST", hierarchical.String())
+	})
 	t.Run("with extra", func(t *testing.T) {
 		asSet.AddExtra("extra", "value")
 		assert.NotNil(t, asSet.Extra)
-		assert.Equal(t, "value", asSet.Extra["extra"])
+		assert.Equal(t, "value", asSet.Extra.Get("extra"))
 		exp := []byte(`as-set: AS-ACME
 members: AS65000
 members: AS-65001
@@ -68,6 +72,10 @@ func Test_ASSetName(t *testing.T) {
 		t.Parallel()
 		assert.Equal(t, "AS-65000", rpsl.ASSetName("65000"))
 	})
+	t.Run("hierarchical", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "AS65000:AS-CUST", rpsl.ASSetName("AS65000:AS-CUST"))
+	})
 }
 
 func Test_ASSetMembers(t *testing.T) {
@@ -104,3 +112,44 @@ func Test_ASSetMembers(t *testing.T) {
 		rpsl.ASSetMembers(65000, "AS-ACME", []any{65001, 65002}),
 	)
 }
+
+func TestASSet_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		asSet rpsl.ASSet
+		err   error
+	}{
+		{"valid", rpsl.ASSet{ASSet: "AS-ACME", Members: []string{"AS65000", "AS-CORP", "AS65000:AS-CUST"}}, nil},
+		{"valid hierarchical", rpsl.ASSet{ASSet: "AS65000:AS-ACME:AS65001"}, nil},
+		{"missing as-set", rpsl.ASSet{Members: []string{"AS65000"}}, rpsl.ErrMissingAttribute},
+		{"no prefix", rpsl.ASSet{ASSet: "ACME"}, rpsl.ErrInvalidSetName},
+		{"hierarchical no set", rpsl.ASSet{ASSet: "AS65000:AS65001"}, rpsl.ErrInvalidSetName},
+		{"hierarchical invalid component", rpsl.ASSet{ASSet: "AS65000:ACME:AS-ACME"}, rpsl.ErrInvalidSetName},
+		{"reserved", rpsl.ASSet{ASSet: "AS-ANY"}, rpsl.ErrInvalidSetName},
+		{"invalid characters", rpsl.ASSet{ASSet: "AS-ACME!"}, rpsl.ErrInvalidSetName},
+		{"invalid member", rpsl.ASSet{ASSet: "AS-ACME", Members: []string{"RS-ACME"}}, rpsl.ErrInvalidSetName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.asSet.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func TestASSet_ValidateDecoded(t *testing.T) {
+	t.Parallel()
+	b := []byte(`as-set: AS-ACME
+members: AS1, AS2, AS-BAR
+members: AS65000:AS-CUST,AS3`)
+	var asSet rpsl.ASSet
+	err := rpsl.UnmarshalBinary(b, &asSet)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AS1", "AS2", "AS-BAR", "AS65000:AS-CUST", "AS3"}, asSet.Members)
+	assert.NoError(t, asSet.Validate())
+}
@@ -0,0 +1,192 @@
+package rpsl
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// ASNFormat is a notation for representing ASNs, per RFC 5396.
+type ASNFormat uint8
+
+const (
+	// ASPlain represents ASNs as a single decimal number, e.g. AS65536. This is the default
+	// notation, and the notation used by RPSL.
+	ASPlain ASNFormat = iota
+	// ASDotPlus represents all ASNs as two 16-bit decimal numbers separated by a dot, e.g. AS0.65000
+	// or AS1.0.
+	ASDotPlus
+	// ASDot represents 2-byte ASNs in asplain notation and 4-byte ASNs in asdot+ notation, e.g.
+	// AS65000 or AS1.0.
+	ASDot
+)
+
+// ParseASN parses an ASN in asplain, asdot, or asdot+ notation, with or without the AS prefix,
+// e.g. AS65536, 65536, AS1.0, or 1.0. Values outside the 32-bit range are rejected.
+func ParseASN(s string) (ASN, error) {
+	v := strings.TrimSpace(s)
+	if len(v) >= 2 && strings.EqualFold(v[:2], "AS") {
+		v = v[2:]
+	}
+	if high, low, isDot := strings.Cut(v, "."); isDot {
+		h, err := parseASNPart(s, high, 16)
+		if err != nil {
+			return 0, err
+		}
+		l, err := parseASNPart(s, low, 16)
+		if err != nil {
+			return 0, err
+		}
+		return ASN(h<<16 | l), nil
+	}
+	u, err := parseASNPart(s, v, 32)
+	if err != nil {
+		return 0, err
+	}
+	return ASN(u), nil
+}
+
+// parseASNPart parses a decimal ASN, or one half of an asdot ASN, of the given bit size.
+func parseASNPart(s, part string, bitSize int) (uint64, error) {
+	// Signs are not permitted in any notation.
+	if part == "" || part[0] == '+' || part[0] == '-' {
+		return 0, fmt.Errorf("%w: value '%s' could not be parsed", ErrInvalidASN, s)
+	}
+	u, err := strconv.ParseUint(part, 10, bitSize)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%w: value '%s' is out of range (maximum %d)", ErrInvalidASN, s, uint64(1)<<bitSize-1)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: value '%s' could not be parsed", ErrInvalidASN, s)
+	}
+	return u, nil
+}
+
+// Format represents the ASN in the given notation, with the AS prefix, e.g. AS1.0.
+func (a ASN) Format(f ASNFormat) string {
+	switch {
+	case f == ASDotPlus, f == ASDot && a > 65535:
+		return "AS" + strconv.FormatUint(uint64(a>>16), 10) + "." + strconv.FormatUint(uint64(a&0xffff), 10)
+	}
+	return a.String()
+}
+
+// IsPrivate determines if the ASN is reserved for private use, per RFC 6996.
+func (a ASN) IsPrivate() bool {
+	return (a >= 64512 && a <= 65534) || (a >= 4200000000 && a <= 4294967294)
+}
+
+// IsDocumentation determines if the ASN is reserved for use in documentation, per RFC 5398.
+func (a ASN) IsDocumentation() bool {
+	return (a >= 64496 && a <= 64511) || (a >= 65536 && a <= 65551)
+}
+
+// IsReserved determines if the ASN is reserved and may not be used for routing: AS0 (RFC 7607),
+// AS_TRANS (RFC 6793), the last ASN of the 2-byte and 4-byte ranges (RFC 7300), and the block
+// reserved by IANA (AS65552-AS131071).
+func (a ASN) IsReserved() bool {
+	switch a {
+	case 0, 23456, 65535, 4294967295:
+		return true
+	}
+	return a >= 65552 && a <= 131071
+}
+
+// ASNRange is an inclusive range of ASNs, as used by as-block objects, e.g. AS64496 - AS64511.
+type ASNRange struct {
+	// First ASN in the range.
+	First ASN
+	// Last ASN in the range.
+	Last ASN
+}
+
+// ParseASNRange parses an ASN range in RPSL format, e.g. AS64496 - AS64511. Each ASN may be in any
+// notation accepted by rpsl.ParseASN. The first ASN must not be greater than the last.
+func ParseASNRange(s string) (ASNRange, error) {
+	first, last, found := strings.Cut(s, "-")
+	if !found {
+		return ASNRange{}, fmt.Errorf("%w: value '%s' is not in the format 'ASx - ASy'", ErrInvalidRange, s)
+	}
+	f, err := ParseASN(first)
+	if err != nil {
+		return ASNRange{}, err
+	}
+	l, err := ParseASN(last)
+	if err != nil {
+		return ASNRange{}, err
+	}
+	if f > l {
+		return ASNRange{}, fmt.Errorf("%w: first ASN %s is greater than last ASN %s", ErrInvalidRange, f, l)
+	}
+	return ASNRange{First: f, Last: l}, nil
+}
+
+// MustParseASNRange calls rpsl.ParseASNRange(s) and panics on error. It is intended for use in
+// tests with hard-coded strings.
+func MustParseASNRange(s string) ASNRange {
+	r, err := ParseASNRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// String represents the range in RPSL format, e.g. AS64496 - AS64511.
+func (r ASNRange) String() string {
+	return r.First.String() + " - " + r.Last.String()
+}
+
+// Format represents the range with both ASNs in the given notation, e.g. AS1.0 - AS1.15.
+func (r ASNRange) Format(f ASNFormat) string {
+	return r.First.Format(f) + " - " + r.Last.Format(f)
+}
+
+// MarshalBinary encodes the range in RPSL format.
+func (r ASNRange) MarshalBinary() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an ASNRange type.
+func (r ASNRange) UnmarshalBinary(b []byte) (ASNRange, error) {
+	return ParseASNRange(string(b))
+}
+
+// Contains determines if the ASN is within the range.
+func (r ASNRange) Contains(a ASN) bool {
+	return a >= r.First && a <= r.Last
+}
+
+// ContainsRange determines if another range is entirely within the range.
+func (r ASNRange) ContainsRange(o ASNRange) bool {
+	return r.Contains(o.First) && r.Contains(o.Last)
+}
+
+// Len returns the number of ASNs in the range. A range whose first ASN is greater than its last
+// has a length of zero.
+func (r ASNRange) Len() uint64 {
+	if r.First > r.Last {
+		return 0
+	}
+	return uint64(r.Last-r.First) + 1
+}
+
+// IsPrivate determines if every ASN in the range is reserved for private use, per RFC 6996.
+func (r ASNRange) IsPrivate() bool {
+	return r.First <= r.Last && r.First.IsPrivate() && r.Last.IsPrivate() && (r.Last <= 65534 || r.First >= 4200000000)
+}
+
+// All returns an iterator over each ASN in the range, in ascending order.
+func (r ASNRange) All() iter.Seq[ASN] {
+	return func(yield func(ASN) bool) {
+		if r.First > r.Last {
+			return
+		}
+		for a := r.First; ; a++ {
+			if !yield(a) || a == r.Last {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_ParseASN(t *testing.T) {
+	cases := []struct {
+		in  string
+		exp rpsl.ASN
+	}{
+		{"65000", 65000},
+		{"AS65000", 65000},
+		{"as65000", 65000},
+		{" AS65000 ", 65000},
+		{"AS4294967295", 4294967295},
+		{"0", 0},
+		{"AS1.10", 65546},
+		{"1.0", 65536},
+		{"AS0.65000", 65000},
+		{"AS65535.65535", 4294967295},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			a, err := rpsl.ParseASN(c.in)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, a)
+		})
+	}
+	errCases := []struct {
+		in  string
+		msg string
+	}{
+		{"", "could not be parsed"},
+		{"AS", "could not be parsed"},
+		{"ASX", "could not be parsed"},
+		{"AS-65000", "could not be parsed"},
+		{"+65000", "could not be parsed"},
+		{"AS1.", "could not be parsed"},
+		{"AS.1", "could not be parsed"},
+		{"AS1.2.3", "could not be parsed"},
+		{"AS4294967296", "out of range (maximum 4294967295)"},
+		{"AS65536.0", "out of range (maximum 65535)"},
+		{"AS1.65536", "out of range (maximum 65535)"},
+	}
+	for _, c := range errCases {
+		t.Run("err "+c.in, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseASN(c.in)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidASN)
+			assert.ErrorContains(t, err, c.msg)
+		})
+	}
+	t.Run("decode asdot", func(t *testing.T) {
+		t.Parallel()
+		var r rpsl.Route
+		err := rpsl.UnmarshalBinary([]byte("route: 192.0.2.0/24\norigin: AS1.10"), &r)
+		require.NoError(t, err)
+		assert.Equal(t, rpsl.ASN(65546), r.Origin)
+	})
+	t.Run("decode out of range", func(t *testing.T) {
+		t.Parallel()
+		var r rpsl.Route
+		err := rpsl.UnmarshalBinary([]byte("route: 192.0.2.0/24\norigin: AS4294967296"), &r)
+		assert.ErrorIs(t, err, rpsl.ErrInvalidASN)
+	})
+}
+
+func Test_ASNFormat(t *testing.T) {
+	cases := []struct {
+		asn     rpsl.ASN
+		plain   string
+		dotPlus string
+		dot     string
+	}{
+		{0, "AS0", "AS0.0", "AS0"},
+		{65000, "AS65000", "AS0.65000", "AS65000"},
+		{65535, "AS65535", "AS0.65535", "AS65535"},
+		{65536, "AS65536", "AS1.0", "AS1.0"},
+		{65546, "AS65546", "AS1.10", "AS1.10"},
+		{4294967295, "AS4294967295", "AS65535.65535", "AS65535.65535"},
+	}
+	for _, c := range cases {
+		t.Run(c.plain, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.plain, c.asn.Format(rpsl.ASPlain))
+			assert.Equal(t, c.dotPlus, c.asn.Format(rpsl.ASDotPlus))
+			assert.Equal(t, c.dot, c.asn.Format(rpsl.ASDot))
+			for _, s := range []string{c.plain, c.dotPlus, c.dot} {
+				a, err := rpsl.ParseASN(s)
+				require.NoError(t, err)
+				assert.Equal(t, c.asn, a)
+			}
+		})
+	}
+}
+
+func Test_ASNClassification(t *testing.T) {
+	cases := []struct {
+		asn           rpsl.ASN
+		private       bool
+		documentation bool
+		reserved      bool
+	}{
+		{0, false, false, true},
+		{1, false, false, false},
+		{23456, false, false, true},
+		{64495, false, false, false},
+		{64496, false, true, false},
+		{64511, false, true, false},
+		{64512, true, false, false},
+		{65534, true, false, false},
+		{65535, false, false, true},
+		{65536, false, true, false},
+		{65551, false, true, false},
+		{65552, false, false, true},
+		{131071, false, false, true},
+		{131072, false, false, false},
+		{4199999999, false, false, false},
+		{4200000000, true, false, false},
+		{4294967294, true, false, false},
+		{4294967295, false, false, true},
+	}
+	for _, c := range cases {
+		t.Run(c.asn.String(), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.private, c.asn.IsPrivate(), "IsPrivate")
+			assert.Equal(t, c.documentation, c.asn.IsDocumentation(), "IsDocumentation")
+			assert.Equal(t, c.reserved, c.asn.IsReserved(), "IsReserved")
+		})
+	}
+}
+
+func Test_ParseASNRange(t *testing.T) {
+	cases := []struct {
+		in  string
+		exp rpsl.ASNRange
+	}{
+		{"AS64496 - AS64511", rpsl.ASNRange{First: 64496, Last: 64511}},
+		{"AS64496-AS64511", rpsl.ASNRange{First: 64496, Last: 64511}},
+		{"as64496 - 64511", rpsl.ASNRange{First: 64496, Last: 64511}},
+		{"AS1.0 - AS1.15", rpsl.ASNRange{First: 65536, Last: 65551}},
+		{"AS65000 - AS65000", rpsl.ASNRange{First: 65000, Last: 65000}},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			r, err := rpsl.ParseASNRange(c.in)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, r)
+		})
+	}
+	errCases := []struct {
+		in  string
+		err error
+	}{
+		{"AS64496", rpsl.ErrInvalidRange},
+		{"AS64511 - AS64496", rpsl.ErrInvalidRange},
+		{"AS64496 - ASX", rpsl.ErrInvalidASN},
+		{"AS64496 -", rpsl.ErrInvalidASN},
+		{"AS64496 - AS4294967296", rpsl.ErrInvalidASN},
+	}
+	for _, c := range errCases {
+		t.Run("err "+c.in, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseASNRange(c.in)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+	t.Run("must panics", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { rpsl.MustParseASNRange("AS64511 - AS64496") })
+	})
+}
+
+func Test_ASNRange(t *testing.T) {
+	r := rpsl.MustParseASNRange("AS64496 - AS64511")
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "AS64496 - AS64511", r.String())
+		b, err := r.MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("AS64496 - AS64511"), b)
+	})
+	t.Run("format", func(t *testing.T) {
+		t.Parallel()
+		r := rpsl.MustParseASNRange("AS64496 - AS65551")
+		assert.Equal(t, "AS64496 - AS65551", r.Format(rpsl.ASPlain))
+		assert.Equal(t, "AS0.64496 - AS1.15", r.Format(rpsl.ASDotPlus))
+		assert.Equal(t, "AS64496 - AS1.15", r.Format(rpsl.ASDot))
+	})
+	t.Run("contains", func(t *testing.T) {
+		t.Parallel()
+		assert.False(t, r.Contains(64495))
+		assert.True(t, r.Contains(64496))
+		assert.True(t, r.Contains(64511))
+		assert.False(t, r.Contains(64512))
+		assert.True(t, r.ContainsRange(rpsl.MustParseASNRange("AS64500 - AS64501")))
+		assert.False(t, r.ContainsRange(rpsl.MustParseASNRange("AS64500 - AS64512")))
+	})
+	t.Run("len", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, uint64(16), r.Len())
+		assert.Equal(t, uint64(1)<<32, rpsl.ASNRange{First: 0, Last: 4294967295}.Len())
+		assert.Zero(t, rpsl.ASNRange{First: 2, Last: 1}.Len())
+	})
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+		var got []rpsl.ASN
+		for a := range r.All() {
+			got = append(got, a)
+		}
+		require.Len(t, got, 16)
+		assert.Equal(t, rpsl.ASN(64496), got[0])
+		assert.Equal(t, rpsl.ASN(64511), got[15])
+	})
+	t.Run("all at maximum", func(t *testing.T) {
+		t.Parallel()
+		var got []rpsl.ASN
+		for a := range (rpsl.ASNRange{First: 4294967294, Last: 4294967295}).All() {
+			got = append(got, a)
+		}
+		assert.Equal(t, []rpsl.ASN{4294967294, 4294967295}, got)
+	})
+	t.Run("all break", func(t *testing.T) {
+		t.Parallel()
+		n := 0
+		for range r.All() {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+		assert.Equal(t, 3, n)
+	})
+	t.Run("private", func(t *testing.T) {
+		t.Parallel()
+		assert.False(t, r.IsPrivate())
+		assert.True(t, rpsl.MustParseASNRange("AS64512 - AS65534").IsPrivate())
+		assert.True(t, rpsl.MustParseASNRange("AS4200000000 - AS4294967294").IsPrivate())
+		assert.False(t, rpsl.MustParseASNRange("AS64512 - AS65535").IsPrivate())
+		assert.False(t, rpsl.MustParseASNRange("AS65000 - AS4200000000").IsPrivate())
+	})
+}
@@ -0,0 +1,14 @@
+package rpsl
+
+import "go.mdl.wtf/rpsl/internal/serialize"
+
+// Attribute is a single RPSL attribute, i.e. a key/value pair.
+type Attribute = serialize.Attribute
+
+// Anchor identifies the attribute that preceded a decoded attribute, by key and occurrence.
+type Anchor = serialize.Anchor
+
+// Attributes is an ordered list of RPSL attributes. Keys may be repeated, and order is retained
+// when encoding. Decoded attributes are encoded after the attribute that preceded them within the
+// object, so that e.g. an attribute after source stays there.
+type Attributes = serialize.Attributes
@@ -1,9 +1,7 @@
 package rpsl
 
 import (
-	"bytes"
 	"errors"
-	"fmt"
 	"strconv"
 )
 
@@ -16,17 +14,9 @@ func (a ASN) String() string {
 	return "AS" + n
 }
 
-// UnmarshalBinary parses a byte string to an ASN type.
+// UnmarshalBinary parses a byte string to an ASN type. See rpsl.ParseASN for accepted notations.
 func (a ASN) UnmarshalBinary(b []byte) (ASN, error) {
-	if bytes.HasPrefix(b, []byte{0x41, 0x53}) {
-		b = b[2:]
-	}
-	u, err := strconv.ParseUint(string(b), 10, 64)
-	if err != nil {
-		err := errors.Join(fmt.Errorf("value '%s' could not be parsed as uint32", string(b)), err)
-		return 0, err
-	}
-	return ASN(u), nil
+	return ParseASN(string(b))
 }
 
 // ASName creates an ASN object name from an ASN uint32 number, e.g. AS65000.
@@ -46,59 +36,79 @@ func AutNumMembersOf(vals ...any) []string {
 type AutNum struct {
 	// AS Number.
 	//    *Required
-	AutNum ASN `rpsl:"aut-num"`
+	AutNum ASN `rpsl:"aut-num,mandatory"`
 	// aut-num object name.
 	//    *Required
-	ASName string `rpsl:"as-name"`
+	ASName string `rpsl:"as-name,mandatory"`
 	// Description for the aut-num object.
-	Description string `rpsl:"descr,omitempty" as:"multiline"`
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
 	// Admin Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	AdminPOC string `rpsl:"admin-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
 	// Technical Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	TechPOC string `rpsl:"tech-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
 	// Maintainer object, the prefix MNT and the Org ID of the organization that configures
 	// (maintains) the IRR object and manages the resource that is specified in the route object.
-	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP.
-	MntBy string `rpsl:"mnt-by,omitempty"`
-	// Import policy expression. See RFC2622 section 6.1.
-	Import string `rpsl:"import,omitempty"`
-	// Export policy expression. See RFC2622 section 6.1.
-	Export string `rpsl:"export,omitempty"`
-	// Multi-protocol import policy expression. See RFC4012 section 2.5.
-	MPImport string `rpsl:"mp-import,omitempty"`
-	// Multi-protocol export policy expression. See RFC4012 section 2.5.
-	MPExport string `rpsl:"mp-export,omitempty"`
+	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP. Multiple maintainers are
+	// separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Import policy expressions, separated by newlines. See RFC2622 section 6.1.
+	Import string `rpsl:"import,omitempty,multiple" as:"repeated"`
+	// Export policy expressions, separated by newlines. See RFC2622 section 6.1.
+	Export string `rpsl:"export,omitempty,multiple" as:"repeated"`
+	// Multi-protocol import policy expressions, separated by newlines. See RFC4012 section 2.5.
+	MPImport string `rpsl:"mp-import,omitempty,multiple" as:"repeated"`
+	// Multi-protocol export policy expressions, separated by newlines. See RFC4012 section 2.5.
+	MPExport string `rpsl:"mp-export,omitempty,multiple" as:"repeated"`
 	// MemberOf can be a list of other aut-num objects or as-set objects of which this aut-num
 	// object is a member.
-	MemberOf []string `rpsl:"member-of,omitempty" as:"comma-space"`
+	MemberOf []string `rpsl:"member-of,omitempty,multiple" as:"comma-space"`
 	// MembersByRef is a list of maintainer names or the keyword ANY. If this attribute is used,
 	// the AS set also includes ASes whose aut-num objects are registered by one of these
 	// maintainers and whose member-of attribute refers to the name of this AS set. If the value
 	// of a mbrs-by-ref attribute is ANY, any AS object referring to the AS set is a member of the
 	// set.  If the mbrs-by-ref attribute is missing, only the ASes listed in the members attribute
 	// are members of the set.
-	MembersByRef []string `rpsl:"mbrs-by-ref,omitempty" as:"comma-space"`
-	// Default routing policies. See RFC 2622 section 6.5.
-	Default string `rpsl:"default,omitempty"`
-	// Multi-protocol default routing policies. See RFC 4012 section 2.5.
-	MPDefault string `rpsl:"mp-default,omitempty"`
-	// Private container for extra attributes
-	Extra map[string]string `rpsl:"-"`
+	MembersByRef []string `rpsl:"mbrs-by-ref,omitempty,multiple" as:"comma-space"`
+	// Default routing policies, separated by newlines. See RFC 2622 section 6.5.
+	Default string `rpsl:"default,omitempty,multiple" as:"repeated"`
+	// Multi-protocol default routing policies, separated by newlines. See RFC 4012 section 2.5.
+	MPDefault string `rpsl:"mp-default,omitempty,multiple" as:"repeated"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
 	// Registry Source. Most registries require this field.
 	Source string `rpsl:"source,omitempty"`
 }
 
 // Add extra pre-formatted attributes to the aut-num object.
 func (a *AutNum) AddExtra(key, value string) {
-	if a.Extra == nil {
-		a.Extra = make(map[string]string)
-	}
-	a.Extra[key] = value
+	a.Extra.Add(key, value)
 }
 
 // String representation of the aut-num in RPSL format. E.g. AS65000.
 func (a *AutNum) String() string {
 	return a.AutNum.String()
 }
+
+// Validate validates the aut-num object, returning all problems found. The aut-num must not be a
+// reserved ASN, and member-of values must be as-set names.
+func (a *AutNum) Validate() error {
+	errs := validateMandatory(a)
+	if a.AutNum != 0 {
+		if err := validateOrigin("aut-num", a.AutNum); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if a.ASName != "" {
+		errs = append(errs, validateNames("as-name", a.ASName)...)
+	}
+	for _, m := range a.MemberOf {
+		if err := validateSetName("member-of", m, "AS-"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateMbrsByRef(a.MembersByRef)...)
+	errs = append(errs, validateContacts(a.AdminPOC, a.TechPOC, a.MntBy)...)
+	return errors.Join(errs...)
+}
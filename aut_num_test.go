@@ -71,7 +71,7 @@ member-of: AS65001, AS65002, AS-ACME`)
 		autNum.Source = "ARIN"
 		autNum.AddExtra("extra", "value")
 		require.NotNil(t, autNum.Extra)
-		assert.Equal(t, "value", autNum.Extra["extra"])
+		assert.Equal(t, "value", autNum.Extra.Get("extra"))
 		exp := []byte(`aut-num: AS65000
 as-name: AS-65000
 member-of: AS65001, AS65002, AS-ACME
@@ -102,3 +102,44 @@ source: ARIN`)
 		assert.Equal(t, exp, result)
 	})
 }
+
+func TestAutNum_Validate(t *testing.T) {
+	cases := []struct {
+		name   string
+		autNum rpsl.AutNum
+		err    error
+	}{
+		{"valid", rpsl.AutNum{AutNum: 65000, ASName: "ACME", MemberOf: []string{"AS-ACME", "AS65000:AS-CORP"}, MembersByRef: []string{"ANY"}}, nil},
+		{"missing as-name", rpsl.AutNum{AutNum: 65000}, rpsl.ErrMissingAttribute},
+		{"reserved aut-num", rpsl.AutNum{AutNum: 65535, ASName: "ACME"}, rpsl.ErrReservedASN},
+		{"invalid as-name", rpsl.AutNum{AutNum: 65000, ASName: "ACME_"}, rpsl.ErrInvalidName},
+		{"invalid member-of", rpsl.AutNum{AutNum: 65000, ASName: "ACME", MemberOf: []string{"RS-ACME"}}, rpsl.ErrInvalidSetName},
+		{"invalid mbrs-by-ref", rpsl.AutNum{AutNum: 65000, ASName: "ACME", MembersByRef: []string{"MNT ACME"}}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.autNum.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func TestAutNum_ValidateDecoded(t *testing.T) {
+	t.Parallel()
+	b := []byte(`aut-num: AS65000
+as-name: ACME
+member-of: AS-A,AS-B
+member-of: AS65000:AS-CORP, AS-C
+mbrs-by-ref: MNT-ACME,MNT-CORP`)
+	var autNum rpsl.AutNum
+	err := rpsl.UnmarshalBinary(b, &autNum)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AS-A", "AS-B", "AS65000:AS-CORP", "AS-C"}, autNum.MemberOf)
+	assert.Equal(t, []string{"MNT-ACME", "MNT-CORP"}, autNum.MembersByRef)
+	assert.NoError(t, autNum.Validate())
+}
@@ -0,0 +1,130 @@
+package rpsl
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+// Decoder reads and decodes RPSL objects from an input stream, such as an IRR database dump.
+// Objects are separated by one or more blank lines and are read one at a time, so the full stream
+// is never held in memory.
+//
+// Example:
+//
+//	dec := rpsl.NewDecoder(f)
+//	for dec.Next() {
+//		obj, err := dec.Decode()
+//		if err != nil {
+//			return err
+//		}
+//		switch o := obj.(type) {
+//		case *rpsl.Route:
+//			fmt.Println(o.Route)
+//		}
+//	}
+//	if err := dec.Err(); err != nil {
+//		return err
+//	}
+type Decoder struct {
+	r    *bufio.Reader
+	buf  []byte
+	err  error
+	opts DecodeOptions
+	// Zero-based index of the current object.
+	index int
+	// Number of lines read from the stream.
+	lines int
+	// Number of lines preceding the current object.
+	start int
+}
+
+// NewDecoder creates a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r), index: -1}
+}
+
+// SetOptions sets the options used to decode each object.
+func (d *Decoder) SetOptions(opts DecodeOptions) {
+	d.opts = opts
+}
+
+// Next advances the decoder to the next object in the stream. It returns false when the end of the
+// stream is reached or an error occurs; use Err to distinguish between the two.
+func (d *Decoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	d.buf = d.buf[:0]
+	// hasAttr tracks whether the current object contains at least one attribute line, so that
+	// paragraphs made up entirely of comments (e.g. dump headers) are skipped.
+	hasAttr := false
+	for {
+		line, err := d.r.ReadBytes(0xa)
+		if len(line) > 0 {
+			d.lines++
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			// A blank line terminates the current object.
+			if hasAttr {
+				d.index++
+				return true
+			}
+			d.buf = d.buf[:0]
+		} else {
+			if len(d.buf) == 0 {
+				d.start = d.lines - 1
+			}
+			if !serialize.IsComment(line) && !serialize.IsContinuation(line) {
+				hasAttr = true
+			}
+			d.buf = append(d.buf, line...)
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				d.err = err
+				return false
+			}
+			d.err = io.EOF
+			if hasAttr {
+				d.index++
+			}
+			return hasAttr
+		}
+	}
+}
+
+// Bytes returns the raw bytes of the current object. The underlying array may be overwritten by a
+// subsequent call to Next.
+func (d *Decoder) Bytes() []byte {
+	return d.buf
+}
+
+// Class returns the class name of the current object, e.g. route or aut-num.
+func (d *Decoder) Class() string {
+	return serialize.Class(d.buf)
+}
+
+// Decode decodes the current object to the Go type registered for its class. The returned value is
+// a pointer to an RPSL struct, e.g. *rpsl.Route, or a generic *rpsl.Object if no type is
+// registered for the class. See rpsl.Register.
+//
+// Any *rpsl.SyntaxError returned reports the index of the object and its line number within the
+// stream.
+func (d *Decoder) Decode() (any, error) {
+	opts := d.opts.serialize()
+	opts.Object = d.index
+	opts.Line = d.start
+	return unmarshalObject(d.buf, opts)
+}
+
+// Err returns the first non-EOF error encountered while reading the stream.
+func (d *Decoder) Err() error {
+	if errors.Is(d.err, io.EOF) {
+		return nil
+	}
+	return d.err
+}
@@ -0,0 +1,155 @@
+package rpsl_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+const dump = `# RADb dump header
+# another comment
+
+route:          192.0.2.0/24
+descr:          ACME
+origin:         AS65000
+mnt-by:         MNT-ACME
+source:         RADB
+
+route6:         2001:db8::/32
+origin:         AS65000
+source:         RADB
+
+
+aut-num:        AS65000
+as-name:        ACME
+member-of:      AS-ACME
+
+as-set:         AS-ACME
+members:        AS65000
+
+route-set:      RS-ACME
+members:        192.0.2.0/24,
+                RS-CORP
+`
+
+func Test_Decoder(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		dec := rpsl.NewDecoder(strings.NewReader(dump))
+		classes := []string{}
+		objs := []any{}
+		for dec.Next() {
+			classes = append(classes, dec.Class())
+			obj, err := dec.Decode()
+			require.NoError(t, err)
+			objs = append(objs, obj)
+		}
+		require.NoError(t, dec.Err())
+		assert.Equal(t, []string{"route", "route6", "aut-num", "as-set", "route-set"}, classes)
+		require.Len(t, objs, 5)
+		route, ok := objs[0].(*rpsl.Route)
+		require.True(t, ok)
+		assert.Equal(t, "192.0.2.0/24", route.Route.String())
+		assert.Equal(t, "ACME", route.Description)
+		assert.Equal(t, rpsl.ASN(65000), route.Origin)
+		assert.Equal(t, "RADB", route.Source)
+		assert.IsType(t, &rpsl.Route6{}, objs[1])
+		autNum, ok := objs[2].(*rpsl.AutNum)
+		require.True(t, ok)
+		assert.Equal(t, rpsl.ASN(65000), autNum.AutNum)
+		assert.Equal(t, []string{"AS-ACME"}, autNum.MemberOf)
+		assert.IsType(t, &rpsl.ASSet{}, objs[3])
+		rs, ok := objs[4].(*rpsl.RouteSet)
+		require.True(t, ok)
+		assert.Equal(t, []string{"192.0.2.0/24", "RS-CORP"}, rs.Members)
+	})
+	t.Run("no trailing newline", func(t *testing.T) {
+		t.Parallel()
+		dec := rpsl.NewDecoder(strings.NewReader("route: 192.0.2.0/24\norigin: AS65000"))
+		require.True(t, dec.Next())
+		assert.Equal(t, "route: 192.0.2.0/24\norigin: AS65000", string(dec.Bytes()))
+		assert.False(t, dec.Next())
+		assert.NoError(t, dec.Err())
+	})
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		dec := rpsl.NewDecoder(strings.NewReader("\n\n# only comments\n\n"))
+		assert.False(t, dec.Next())
+		assert.NoError(t, dec.Err())
+	})
+	t.Run("unknown auth scheme", func(t *testing.T) {
+		t.Parallel()
+		dec := rpsl.NewDecoder(strings.NewReader("mntner: MNT-OLD\nauth: CRYPT-PW abcdefgh\nsource: RADB\n\n" +
+			"route: 192.0.2.0/24\norigin: AS65000\nmnt-by: MNT-OLD\n"))
+		objs := []any{}
+		for dec.Next() {
+			obj, err := dec.Decode()
+			require.NoError(t, err)
+			objs = append(objs, obj)
+		}
+		require.NoError(t, dec.Err())
+		require.Len(t, objs, 2)
+		mntner, ok := objs[0].(*rpsl.Mntner)
+		require.True(t, ok)
+		assert.Equal(t, []rpsl.Auth{{Scheme: "CRYPT-PW", Value: "abcdefgh"}}, mntner.Auth)
+		assert.IsType(t, &rpsl.Route{}, objs[1])
+	})
+	t.Run("unknown class", func(t *testing.T) {
+		t.Parallel()
+		dec := rpsl.NewDecoder(strings.NewReader("organisation: ORG-ACME1-RIPE\n"))
+		require.True(t, dec.Next())
+		obj, err := dec.Decode()
+		require.NoError(t, err)
+		o, ok := obj.(*rpsl.Object)
+		require.True(t, ok)
+		assert.Equal(t, "organisation", o.Class())
+		assert.Equal(t, "ORG-ACME1-RIPE", o.Key())
+	})
+	t.Run("decode error", func(t *testing.T) {
+		t.Parallel()
+		dec := rpsl.NewDecoder(strings.NewReader("route: 192.0.2.0/24\norigin: ASX\n"))
+		require.True(t, dec.Next())
+		_, err := dec.Decode()
+		assert.ErrorContains(t, err, "origin")
+	})
+	t.Run("syntax error position", func(t *testing.T) {
+		t.Parallel()
+		in := `route: 192.0.2.0/24
+origin: AS65000
+
+# comment
+
+route: 198.51.100.0/24
+origin: ASX
+origin: ASY
+`
+		dec := rpsl.NewDecoder(strings.NewReader(in))
+		dec.SetOptions(rpsl.DecodeOptions{AllErrors: true})
+		require.True(t, dec.Next())
+		_, err := dec.Decode()
+		require.NoError(t, err)
+		require.True(t, dec.Next())
+		_, err = dec.Decode()
+		var synErr *rpsl.SyntaxError
+		require.ErrorAs(t, err, &synErr)
+		assert.Equal(t, 1, synErr.Object)
+		assert.Equal(t, 7, synErr.Line)
+		assert.Equal(t, "origin", synErr.Attribute)
+		assert.Equal(t, "ASX", synErr.Value)
+		assert.ErrorContains(t, err, "line 8")
+		assert.False(t, dec.Next())
+	})
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+		readErr := errors.New("read error")
+		dec := rpsl.NewDecoder(iotest.ErrReader(readErr))
+		assert.False(t, dec.Next())
+		assert.False(t, dec.Next())
+		assert.ErrorIs(t, dec.Err(), readErr)
+	})
+}
@@ -0,0 +1,53 @@
+package rpsl
+
+import (
+	"bufio"
+	"io"
+
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+// Encoder writes RPSL objects to an output stream. Objects are separated by a blank line, and each
+// object is flushed to the underlying writer once it has been fully written.
+//
+// Example:
+//
+//	enc := rpsl.NewEncoder(os.Stdout)
+//	for _, route := range routes {
+//		if err := enc.Encode(&route); err != nil {
+//			return err
+//		}
+//	}
+type Encoder struct {
+	w    *bufio.Writer
+	n    int
+	opts EncodeOptions
+}
+
+// NewEncoder creates a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// SetOptions sets the options used to encode each object.
+func (e *Encoder) SetOptions(opts EncodeOptions) {
+	e.opts = opts
+}
+
+// Encode writes the RPSL encoding of o to the stream, followed by a newline. The argument must be
+// a pointer to an RPSL struct.
+func (e *Encoder) Encode(o any) error {
+	if e.n > 0 {
+		if err := e.w.WriteByte(0xa); err != nil {
+			return err
+		}
+	}
+	if err := serialize.EncodeTo(e.w, e.opts.object(o)); err != nil {
+		return err
+	}
+	if err := e.w.WriteByte(0xa); err != nil {
+		return err
+	}
+	e.n++
+	return e.w.Flush()
+}
@@ -0,0 +1,69 @@
+package rpsl_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write error")
+}
+
+func Test_Encoder(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		enc := rpsl.NewEncoder(&buf)
+		err := enc.Encode(&rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000})
+		require.NoError(t, err)
+		assert.Equal(t, "route: 192.0.2.0/24\norigin: AS65000\n", buf.String())
+		err = enc.Encode(&rpsl.Route6{Route6: rpsl.MustParseIPv6Prefix("2001:db8::/32"), Origin: 65000})
+		require.NoError(t, err)
+		exp := `route: 192.0.2.0/24
+origin: AS65000
+
+route6: 2001:db8::/32
+origin: AS65000
+`
+		assert.Equal(t, exp, buf.String())
+	})
+	t.Run("decoder round trip", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		enc := rpsl.NewEncoder(&buf)
+		for _, origin := range []rpsl.ASN{65000, 65001, 65002} {
+			err := enc.Encode(&rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: origin})
+			require.NoError(t, err)
+		}
+		dec := rpsl.NewDecoder(&buf)
+		origins := []rpsl.ASN{}
+		for dec.Next() {
+			obj, err := dec.Decode()
+			require.NoError(t, err)
+			origins = append(origins, obj.(*rpsl.Route).Origin)
+		}
+		require.NoError(t, dec.Err())
+		assert.Equal(t, []rpsl.ASN{65000, 65001, 65002}, origins)
+	})
+	t.Run("err non struct", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		enc := rpsl.NewEncoder(&buf)
+		err := enc.Encode("non-struct")
+		assert.Error(t, err)
+		assert.Zero(t, buf.Len())
+	})
+	t.Run("err write", func(t *testing.T) {
+		t.Parallel()
+		enc := rpsl.NewEncoder(errWriter{})
+		err := enc.Encode(&rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000})
+		assert.ErrorContains(t, err, "write error")
+	})
+}
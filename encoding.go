@@ -3,14 +3,15 @@ package rpsl
 import "go.mdl.wtf/rpsl/internal/serialize"
 
 // MarshalBinary encodes an RPSL data structure as a byte string. The argument must be a pointer to
-// a struct.
+// a struct. Secret mntner auth values are filtered, as registries publish them; see
+// rpsl.EncodeOptions to keep them.
 //
 // Example:
 //
 //	b, err := rpsl.MarshalBinary(&route)
 //	fmt.Println(string(b))
 func MarshalBinary(o any) ([]byte, error) {
-	return serialize.Encode(o)
+	return EncodeOptions{}.MarshalBinary(o)
 }
 
 // UnmarshalBinary decodes a byte string of RPSL data to a Go RPSL object.
@@ -24,3 +25,67 @@ func MarshalBinary(o any) ([]byte, error) {
 func UnmarshalBinary(b []byte, o any) error {
 	return serialize.Decode(b, o)
 }
+
+// DecodeOptions configures how RPSL objects are decoded.
+//
+// Example:
+//
+//	opts := rpsl.DecodeOptions{AllErrors: true}
+//	err := opts.UnmarshalBinary(b, &route)
+//	var synErr *rpsl.SyntaxError
+//	if errors.As(err, &synErr) {
+//		fmt.Println(synErr.Line, synErr.Attribute)
+//	}
+type DecodeOptions struct {
+	// AllErrors causes all errors in an object to be collected and returned together (see
+	// errors.Join), rather than stopping at the first error.
+	AllErrors bool
+	// Strict causes objects with missing mandatory attributes, repeated single-valued attributes,
+	// or unknown attributes to be rejected, rather than unknown attributes being placed into the
+	// 'Extra' field. Mandatory and multi-valued attributes are those whose rpsl tag includes
+	// 'mandatory' and 'multiple' respectively. Generic objects accept any attribute.
+	Strict bool
+}
+
+func (opts DecodeOptions) serialize() serialize.Options {
+	return serialize.Options{AllErrors: opts.AllErrors, Strict: opts.Strict}
+}
+
+// EncodeOptions configures how RPSL objects are encoded.
+//
+// Example:
+//
+//	opts := rpsl.EncodeOptions{Secrets: true}
+//	b, err := opts.MarshalBinary(&mntner)
+type EncodeOptions struct {
+	// Secrets causes the password hashes and SSO accounts of mntner auth values to be written as
+	// they are, e.g. to submit a mntner to a registry. By default they are filtered, e.g.
+	// BCRYPT-PW # Filtered, so that objects can be published as registries publish them.
+	Secrets bool
+}
+
+// object returns the object to encode in place of o, with any secrets filtered.
+func (opts EncodeOptions) object(o any) any {
+	if opts.Secrets {
+		return o
+	}
+	switch m := o.(type) {
+	case *Mntner:
+		return m.Filter()
+	case Mntner:
+		return m.Filter()
+	}
+	return o
+}
+
+// MarshalBinary encodes an RPSL data structure as a byte string, using the options. The argument
+// must be a pointer to a struct.
+func (opts EncodeOptions) MarshalBinary(o any) ([]byte, error) {
+	return serialize.Encode(opts.object(o))
+}
+
+// UnmarshalBinary decodes a byte string of RPSL data to a Go RPSL object, using the options.
+// The second argument must be a pointer to an RPSL struct.
+func (opts DecodeOptions) UnmarshalBinary(b []byte, o any) error {
+	return serialize.DecodeWithOptions(b, o, opts.serialize())
+}
@@ -14,7 +14,7 @@ origin: AS65000`)
 func Test_MarshalBinary(t *testing.T) {
 	t.Parallel()
 	obj := rpsl.Route{
-		Route:  "192.0.2.0/24",
+		Route:  rpsl.MustParseIPv4Prefix("192.0.2.0/24"),
 		Origin: 65000,
 	}
 
@@ -23,10 +23,278 @@ func Test_MarshalBinary(t *testing.T) {
 	assert.Equal(t, b, result)
 }
 
+func Test_DecodeOptions(t *testing.T) {
+	t.Parallel()
+	b := []byte(`aut-num: ASX
+as-name: ACME
+member-of: AS-ACME`)
+	var obj rpsl.AutNum
+	err := rpsl.DecodeOptions{AllErrors: true}.UnmarshalBinary(b, &obj)
+	var synErr *rpsl.SyntaxError
+	require.ErrorAs(t, err, &synErr)
+	assert.Equal(t, 1, synErr.Line)
+	assert.Equal(t, "aut-num", synErr.Attribute)
+	assert.Equal(t, "ACME", obj.ASName)
+	assert.Equal(t, []string{"AS-ACME"}, obj.MemberOf)
+}
+
+func Test_DecodeOptionsStrict(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`aut-num: AS65000
+as-name: ACME
+mnt-by: MNT-ONE
+mnt-by: MNT-TWO
+import: from AS65001 accept ANY
+import: from AS65002 accept ANY`)
+		var obj rpsl.AutNum
+		err := rpsl.DecodeOptions{Strict: true}.UnmarshalBinary(b, &obj)
+		require.NoError(t, err)
+		assert.Equal(t, "from AS65001 accept ANY\nfrom AS65002 accept ANY", obj.Import)
+		assert.Equal(t, "MNT-ONE\nMNT-TWO", obj.MntBy)
+		result, err := rpsl.MarshalBinary(&obj)
+		require.NoError(t, err)
+		assert.Equal(t, b, result)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`aut-num: AS65000
+as-name: ACME
+as-name: ACME2
+unknown: value`)
+		var obj rpsl.AutNum
+		err := rpsl.DecodeOptions{Strict: true, AllErrors: true}.UnmarshalBinary(b, &obj)
+		assert.ErrorIs(t, err, rpsl.ErrDuplicateAttribute)
+		assert.ErrorIs(t, err, rpsl.ErrUnknownAttribute)
+		assert.NotErrorIs(t, err, rpsl.ErrMissingAttribute)
+	})
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+		obj, err := rpsl.DecodeOptions{Strict: true}.UnmarshalObject([]byte(`route-set: RS-ACME`))
+		require.NoError(t, err)
+		assert.IsType(t, &rpsl.RouteSet{}, obj)
+		_, err = rpsl.DecodeOptions{Strict: true}.UnmarshalObject([]byte(`aut-num: AS65000`))
+		assert.ErrorIs(t, err, rpsl.ErrMissingAttribute)
+		assert.ErrorContains(t, err, "as-name")
+	})
+}
+
 func Test_UnmarshalBinary(t *testing.T) {
 	var obj rpsl.Route
 	err := rpsl.UnmarshalBinary(b, &obj)
 	require.NoError(t, err)
-	assert.Equal(t, "192.0.2.0/24", obj.Route)
+	assert.Equal(t, "192.0.2.0/24", obj.Route.String())
 	assert.Equal(t, rpsl.ASN(65000), obj.Origin)
 }
+
+func Test_RoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		in   any
+		out  any
+	}{
+		{
+			name: "route",
+			in: &rpsl.Route{
+				Route:       rpsl.MustParseIPv4Prefix("192.0.2.0/24"),
+				Origin:      65000,
+				Description: "Line 1\nLine 2",
+				AdminPOC:    "TEST-ADMIN",
+				TechPOC:     "TEST-TECH",
+				MntBy:       "MNT-TEST",
+				Source:      "ARIN",
+			},
+			out: &rpsl.Route{},
+		},
+		{
+			name: "route6",
+			in: &rpsl.Route6{
+				Route6:      rpsl.MustParseIPv6Prefix("2001:db8::/32"),
+				Origin:      65000,
+				Description: "test",
+				AdminPOC:    "TEST-ADMIN",
+				TechPOC:     "TEST-TECH",
+				MntBy:       "MNT-TEST",
+				Source:      "ARIN",
+			},
+			out: &rpsl.Route6{},
+		},
+		{
+			name: "aut-num",
+			in: &rpsl.AutNum{
+				AutNum:       65000,
+				ASName:       "ACME",
+				Description:  "test",
+				Import:       "from AS65001 accept ANY",
+				Export:       "to AS65001 announce AS65000",
+				MPImport:     "afi ipv6.unicast from AS65001 accept ANY",
+				MPExport:     "afi ipv6.unicast to AS65001 announce AS65000",
+				MemberOf:     []string{"AS-ACME", "AS65000:AS-CORP"},
+				MembersByRef: []string{"MNT-TEST"},
+				Source:       "ARIN",
+			},
+			out: &rpsl.AutNum{},
+		},
+		{
+			name: "as-set",
+			in: &rpsl.ASSet{
+				ASSet:   "AS65000:AS-ACME",
+				Remarks: "remark",
+				Members: []string{"AS65000", "AS-CORP"},
+				Source:  "ARIN",
+			},
+			out: &rpsl.ASSet{},
+		},
+		{
+			name: "route-set",
+			in: &rpsl.RouteSet{
+				RouteSet:  "AS65000:RS-ACME",
+				Members:   []string{"192.0.2.0/24", "RS-CORP"},
+				MPMembers: []string{"2001:db8::/32", "2001:db8:1::/48", "AS65000:RS-CORP"},
+				Source:    "ARIN",
+			},
+			out: &rpsl.RouteSet{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			b, err := rpsl.MarshalBinary(c.in)
+			require.NoError(t, err)
+			err = rpsl.UnmarshalBinary(b, c.out)
+			require.NoError(t, err)
+			assert.Equal(t, c.in, c.out)
+			result, err := rpsl.MarshalBinary(c.out)
+			require.NoError(t, err)
+			assert.Equal(t, b, result)
+		})
+	}
+}
+
+func Test_RoundTripExtra(t *testing.T) {
+	t.Parallel()
+	b := []byte(`route: 192.0.2.0/24
+origin: AS65000
+notify: noc@example.com
+notify: abuse@example.com
+last-modified: 2024-01-02T03:04:05Z
+source: ARIN`)
+	for range 10 {
+		var obj rpsl.Route
+		err := rpsl.UnmarshalBinary(b, &obj)
+		require.NoError(t, err)
+		result, err := rpsl.MarshalBinary(&obj)
+		require.NoError(t, err)
+		assert.Equal(t, b, result)
+	}
+}
+
+func Test_RoundTripExtraOrder(t *testing.T) {
+	t.Parallel()
+	b := []byte(`route: 192.0.2.0/24
+remarks-a: one
+origin: AS65000
+source: ARIN
+remarks-b: two`)
+	var obj rpsl.Route
+	err := rpsl.UnmarshalBinary(b, &obj)
+	require.NoError(t, err)
+	result, err := rpsl.MarshalBinary(&obj)
+	require.NoError(t, err)
+	assert.Equal(t, b, result)
+	obj.AddExtra("notify", "noc@example.com")
+	result, err = rpsl.MarshalBinary(&obj)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`route: 192.0.2.0/24
+remarks-a: one
+origin: AS65000
+notify: noc@example.com
+source: ARIN
+remarks-b: two`), result)
+}
+
+func Test_RoundTripExtraEdited(t *testing.T) {
+	t.Run("more lines", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route: 192.0.2.0/24
+origin: AS65000
+remarks: one
+source: ARIN
+last-modified: 2024-01-02T03:04:05Z`)
+		var obj rpsl.Route
+		err := rpsl.UnmarshalBinary(b, &obj)
+		require.NoError(t, err)
+		obj.Description = "x\ny"
+		result, err := rpsl.MarshalBinary(&obj)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`route: 192.0.2.0/24
+origin: AS65000
+remarks: one
+descr: x
+descr: y
+source: ARIN
+last-modified: 2024-01-02T03:04:05Z`), result)
+	})
+	t.Run("fewer lines", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`aut-num: AS65000
+as-name: ACME
+descr: line 1
+descr: line 2
+descr: line 3
+remarks: one
+source: ARIN`)
+		var obj rpsl.AutNum
+		err := rpsl.UnmarshalBinary(b, &obj)
+		require.NoError(t, err)
+		obj.Description = ""
+		result, err := rpsl.MarshalBinary(&obj)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`aut-num: AS65000
+as-name: ACME
+remarks: one
+source: ARIN`), result)
+	})
+	t.Run("removed anchor", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`aut-num: AS65000
+as-name: ACME
+descr: line 1
+remarks: one
+descr: line 2
+remarks: two
+source: ARIN`)
+		var obj rpsl.AutNum
+		err := rpsl.UnmarshalBinary(b, &obj)
+		require.NoError(t, err)
+		obj.Description = "line 1"
+		result, err := rpsl.MarshalBinary(&obj)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`aut-num: AS65000
+as-name: ACME
+descr: line 1
+remarks: one
+remarks: two
+source: ARIN`), result)
+	})
+}
+
+func Test_RoundTripContinuation(t *testing.T) {
+	t.Parallel()
+	b := []byte(`aut-num: AS65000
+as-name: ACME
+descr: line 1
+       line 2
+import: from AS65001
++       accept ANY`)
+	var obj rpsl.AutNum
+	err := rpsl.UnmarshalBinary(b, &obj)
+	require.NoError(t, err)
+	result, err := rpsl.MarshalBinary(&obj)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`aut-num: AS65000
+as-name: ACME
+descr: line 1
+descr: line 2
+import: from AS65001 accept ANY`), result)
+}
@@ -1,9 +1,29 @@
 package rpsl
 
 import (
+	"errors"
+
 	"go.mdl.wtf/rpsl/internal/serialize"
 )
 
 // UnmarshalBinaryErr describes an invalid argument passed to rpsl.UnmarshalBinary.
 // The argument to rpsl.UnmarshalBinary must be a non-nil pointer.
 type UnmarshalBinaryErr = serialize.UnmarshalBinaryErr
+
+// ErrEmptyObject describes an RPSL object without any attributes, whose class therefore cannot be
+// determined.
+var ErrEmptyObject = errors.New("rpsl: object has no attributes")
+
+// SyntaxError describes an RPSL attribute whose value could not be decoded, including its position
+// within the input. Use errors.As to retrieve it from an error returned by any decoding function.
+type SyntaxError = serialize.SyntaxError
+
+// ErrMissingAttribute describes a mandatory attribute that is missing from an object.
+var ErrMissingAttribute = serialize.ErrMissingAttribute
+
+// ErrDuplicateAttribute describes a single-valued attribute that appears more than once in an
+// object.
+var ErrDuplicateAttribute = serialize.ErrDuplicateAttribute
+
+// ErrUnknownAttribute describes an attribute that is not defined for an object's class.
+var ErrUnknownAttribute = serialize.ErrUnknownAttribute
@@ -0,0 +1,77 @@
+package rpsl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FilterSet is an RPSL 'filter-set class' object. A filter-set defines a set of routes that are
+// matched by its filter, and is referred to by name in the policies of aut-num objects. See RFC
+// 2622 section 5.4 and RFC 4012 section 2.5.
+type FilterSet struct {
+	// Name of the filter-set. Begins with FLTR-, and may be hierarchical, e.g. AS65000:FLTR-BOGONS.
+	//    *Required
+	FilterSet string `rpsl:"filter-set,mandatory"`
+	// Description for the filter-set object.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// Policy filter expression matching IPv4 routes, e.g. { 192.0.2.0/24^+ }.
+	Filter string `rpsl:"filter,omitempty"`
+	// Policy filter expression matching IPv4 or IPv6 routes, e.g. { 2001:db8::/32^+ }.
+	MPFilter string `rpsl:"mp-filter,omitempty"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the filter-set object. Multiple maintainers are separated
+	// by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the filter-set object.
+func (f *FilterSet) AddExtra(key, value string) {
+	f.Extra.Add(key, value)
+}
+
+// String representation of the filter-set in RPSL format. E.g. FLTR-BOGONS or
+// AS65000:FLTR-BOGONS.
+func (f *FilterSet) String() string {
+	if n, err := ParseSetName(f.FilterSet, FilterSetPrefix); err == nil {
+		return n.String()
+	}
+	return FilterSetName(f.FilterSet)
+}
+
+// Validate validates the filter-set object, returning all problems found. The filter-set name must
+// comply with RFC 2622 section 5, and exactly one of filter or mp-filter must be present, with
+// balanced brackets.
+func (f *FilterSet) Validate() error {
+	errs := validateMandatory(f)
+	if f.FilterSet != "" {
+		if err := validateSetName("filter-set", f.FilterSet, FilterSetPrefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	switch {
+	case f.Filter == "" && f.MPFilter == "":
+		errs = append(errs, &ValidationError{Attribute: "filter", Err: ErrMissingAttribute})
+	case f.Filter != "" && f.MPFilter != "":
+		err := fmt.Errorf("%w: filter and mp-filter are mutually exclusive", ErrInvalidFilter)
+		errs = append(errs, &ValidationError{Attribute: "mp-filter", Err: err})
+	case f.Filter != "":
+		if err := validateFilter("filter", f.Filter); err != nil {
+			errs = append(errs, err)
+		}
+	default:
+		if err := validateFilter("mp-filter", f.MPFilter); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateContacts(f.AdminPOC, f.TechPOC, f.MntBy)...)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,81 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestFilterSet_RPSL(t *testing.T) {
+	t.Parallel()
+	f := rpsl.FilterSet{
+		FilterSet:   "FLTR-BOGONS",
+		Description: "Bogon prefixes",
+		Filter:      "{ 0.0.0.0/8^+, 10.0.0.0/8^+, 192.0.2.0/24^+ }",
+		MntBy:       "MNT-ACME",
+		Source:      "TEST",
+	}
+	exp := []byte(`filter-set: FLTR-BOGONS
+descr: Bogon prefixes
+filter: { 0.0.0.0/8^+, 10.0.0.0/8^+, 192.0.2.0/24^+ }
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&f)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "FLTR-BOGONS", f.String())
+		assert.Equal(t, "AS65000:FLTR-BOGONS", (&rpsl.FilterSet{FilterSet: "AS65000:FLTR-BOGONS"}).String())
+		assert.Equal(t, "FLTR-BOGONS", (&rpsl.FilterSet{FilterSet: "BOGONS"}).String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.FilterSet
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, f, decoded)
+	})
+	t.Run("decode continuation", func(t *testing.T) {
+		var decoded rpsl.FilterSet
+		err := rpsl.UnmarshalBinary([]byte(`filter-set: AS65000:FLTR-MARTIAN
+mp-filter: { 0.0.0.0/8^+,
+    2001:db8::/32^+ }`), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, "{ 0.0.0.0/8^+, 2001:db8::/32^+ }", decoded.MPFilter)
+		assert.NoError(t, decoded.Validate())
+	})
+}
+
+func TestFilterSet_Validate(t *testing.T) {
+	cases := []struct {
+		name string
+		f    rpsl.FilterSet
+		err  error
+	}{
+		{"valid filter", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", Filter: "{ 192.0.2.0/24^+ }"}, nil},
+		{"valid mp-filter", rpsl.FilterSet{FilterSet: "AS65000:FLTR-BOGONS", MPFilter: "AS65000 AND <^AS65000+$>"}, nil},
+		{"valid nested", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", Filter: "(FLTR-A OR { 192.0.2.0/24 }) AND NOT <AS-ACME>"}, nil},
+		{"missing filter-set", rpsl.FilterSet{Filter: "ANY"}, rpsl.ErrMissingAttribute},
+		{"missing filter", rpsl.FilterSet{FilterSet: "FLTR-BOGONS"}, rpsl.ErrMissingAttribute},
+		{"both filters", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", Filter: "ANY", MPFilter: "ANY"}, rpsl.ErrInvalidFilter},
+		{"invalid name", rpsl.FilterSet{FilterSet: "BOGONS", Filter: "ANY"}, rpsl.ErrInvalidSetName},
+		{"unbalanced braces", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", Filter: "{ 192.0.2.0/24"}, rpsl.ErrInvalidFilter},
+		{"mismatched brackets", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", MPFilter: "(<AS65000)>"}, rpsl.ErrInvalidFilter},
+		{"extra closer", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", Filter: "ANY }"}, rpsl.ErrInvalidFilter},
+		{"invalid mnt-by", rpsl.FilterSet{FilterSet: "FLTR-BOGONS", Filter: "ANY", MntBy: "MNT ACME"}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.f.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
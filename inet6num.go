@@ -0,0 +1,50 @@
+package rpsl
+
+import (
+	"errors"
+	"net/netip"
+)
+
+// Inet6num is an RPSL 'inet6num class' object. An inet6num object records the allocation or
+// assignment of an IPv6 prefix, which route6 objects for the prefix should be covered by.
+type Inet6num struct {
+	// IPv6 address prefix, without host bits set.
+	//    *Required
+	Inet6num IPv6Prefix `rpsl:"inet6num,mandatory"`
+	// Attributes of the network to which the prefix is allocated or assigned.
+	Network
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the inet6num object.
+func (n *Inet6num) AddExtra(key, value string) {
+	n.Extra.Add(key, value)
+}
+
+// String representation of the inet6num in RPSL format. E.g. 2001:db8::/32.
+func (n *Inet6num) String() string {
+	return n.Inet6num.String()
+}
+
+// Covers determines if a prefix, e.g. that of a route6 object, is entirely within the inet6num.
+func (n *Inet6num) Covers(p netip.Prefix) bool {
+	return n.Inet6num.IsValid() && p.IsValid() && p.Addr().Is6() &&
+		p.Bits() >= n.Inet6num.Bits() && n.Inet6num.Contains(p.Addr())
+}
+
+// Validate validates the inet6num object, returning all problems found. The inet6num must be a
+// valid IPv6 prefix without host bits set, the netname must be a valid object name, and country
+// values must be country codes.
+func (n *Inet6num) Validate() error {
+	errs := validateMandatory(n)
+	if n.Inet6num.IsValid() {
+		if err := checkPrefix(n.Inet6num.Prefix, 6); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "inet6num", Value: n.Inet6num.String(), Err: err})
+		}
+	}
+	errs = append(errs, n.Network.validate()...)
+	return errors.Join(errs...)
+}
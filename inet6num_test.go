@@ -0,0 +1,77 @@
+package rpsl_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestInet6num_RPSL(t *testing.T) {
+	t.Parallel()
+	n := rpsl.Inet6num{
+		Inet6num: rpsl.MustParseIPv6Prefix("2001:db8::/32"),
+		Network: rpsl.Network{
+			NetName:  "ACME-NET6",
+			Country:  "US",
+			Status:   "ALLOCATED-BY-RIR",
+			MntLower: "MNT-ACME",
+		},
+		Source: "TEST",
+	}
+	exp := []byte(`inet6num: 2001:db8::/32
+netname: ACME-NET6
+country: US
+status: ALLOCATED-BY-RIR
+mnt-lower: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&n)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "2001:db8::/32", n.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.Inet6num
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, n, decoded)
+	})
+	t.Run("covers", func(t *testing.T) {
+		assert.True(t, n.Covers(netip.MustParsePrefix("2001:db8:1::/48")))
+		assert.True(t, n.Covers(netip.MustParsePrefix("2001:db8::/32")))
+		assert.False(t, n.Covers(netip.MustParsePrefix("2001:db8::/31")))
+		assert.False(t, n.Covers(netip.MustParsePrefix("192.0.2.0/24")))
+	})
+}
+
+func TestInet6num_Validate(t *testing.T) {
+	p := rpsl.MustParseIPv6Prefix("2001:db8::/32")
+	net := rpsl.Network{NetName: "ACME-NET6"}
+	cases := []struct {
+		name     string
+		inet6num rpsl.Inet6num
+		err      error
+	}{
+		{"valid", rpsl.Inet6num{Inet6num: p, Network: net}, nil},
+		{"missing inet6num", rpsl.Inet6num{Network: net}, rpsl.ErrMissingAttribute},
+		{"missing netname", rpsl.Inet6num{Inet6num: p}, rpsl.ErrMissingAttribute},
+		{"host bits", rpsl.Inet6num{Inet6num: rpsl.IPv6Prefix{Prefix: netip.MustParsePrefix("2001:db8::1/32")}, Network: net}, rpsl.ErrHostBits},
+		{"wrong family", rpsl.Inet6num{Inet6num: rpsl.IPv6Prefix{Prefix: netip.MustParsePrefix("192.0.2.0/24")}, Network: net}, rpsl.ErrWrongFamily},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.inet6num.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package rpsl
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Tunnel is the tunnel of an inet-rtr interface, per RFC 4012 section 4.5.
+type Tunnel struct {
+	// Address of the tunnel's remote endpoint.
+	Remote netip.Addr
+	// Encapsulation of the tunnel, e.g. GRE or IPinIP.
+	Encapsulation string
+}
+
+// IfAddr is an inet-rtr ifaddr or interface value, e.g. 192.0.2.1 masklen 30 action pref = 10;.
+// See RFC 2622 section 9 and RFC 4012 section 4.5.
+type IfAddr struct {
+	// Address of the interface.
+	Addr netip.Addr
+	// Mask length of the interface's subnet.
+	MaskLen int
+	// Tunnel of the interface. Only interface values may have a tunnel.
+	Tunnel Tunnel
+	// Policy action, e.g. pref = 10;. The action is not parsed.
+	Action string
+}
+
+// actionKeyword matches the action keyword of an ifaddr or interface value, which is
+// case-insensitive like the other keywords.
+var actionKeyword = regexp.MustCompile(`(?i)\saction\s`)
+
+// ParseIfAddr parses an inet-rtr ifaddr or interface value, e.g. 192.0.2.1 masklen 30 or
+// 2001:db8::1 masklen 64 tunnel 198.51.100.1,GRE action pref = 10;.
+func ParseIfAddr(s string) (IfAddr, error) {
+	s = strings.TrimSpace(s)
+	rest, action, hasAction := s, "", false
+	if loc := actionKeyword.FindStringIndex(s); loc != nil {
+		rest, action, hasAction = s[:loc[0]], s[loc[1]:], true
+	}
+	fields := strings.Fields(rest)
+	if len(fields) != 3 && len(fields) != 5 || !strings.EqualFold(fields[1], "masklen") {
+		return IfAddr{}, fmt.Errorf("%w: value '%s' is not in the format '<address> masklen <length>'", ErrInvalidInterface, s)
+	}
+	var i IfAddr
+	var err error
+	if i.Addr, err = netip.ParseAddr(fields[0]); err != nil {
+		return IfAddr{}, fmt.Errorf("%w: %w", ErrInvalidInterface, err)
+	}
+	if i.MaskLen, err = strconv.Atoi(fields[2]); err != nil {
+		return IfAddr{}, fmt.Errorf("%w: masklen '%s' could not be parsed", ErrInvalidInterface, fields[2])
+	}
+	if len(fields) == 5 {
+		remote, encapsulation, _ := strings.Cut(fields[4], ",")
+		if !strings.EqualFold(fields[3], "tunnel") {
+			return IfAddr{}, fmt.Errorf("%w: unexpected '%s'", ErrInvalidInterface, fields[3])
+		}
+		if i.Tunnel.Remote, err = netip.ParseAddr(remote); err != nil {
+			return IfAddr{}, fmt.Errorf("%w: tunnel: %w", ErrInvalidInterface, err)
+		}
+		i.Tunnel.Encapsulation = encapsulation
+	}
+	if hasAction {
+		i.Action = strings.TrimSpace(action)
+	}
+	if err := i.check(0); err != nil {
+		return IfAddr{}, err
+	}
+	return i, nil
+}
+
+// check checks that the interface's address is of the given family (4 or 6, or 0 for either), and
+// that its mask length and tunnel are valid.
+func (i IfAddr) check(family int) error {
+	if !i.Addr.IsValid() {
+		return fmt.Errorf("%w: missing address", ErrInvalidInterface)
+	}
+	if (family == 4 && !i.Addr.Is4()) || (family == 6 && !i.Addr.Is6()) {
+		return fmt.Errorf("%w: %w", ErrInvalidInterface, ErrWrongFamily)
+	}
+	if i.MaskLen < 0 || i.MaskLen > i.Addr.BitLen() {
+		return fmt.Errorf("%w: masklen %d is out of range (maximum %d)", ErrInvalidInterface, i.MaskLen, i.Addr.BitLen())
+	}
+	if i.Tunnel != (Tunnel{}) {
+		if !i.Tunnel.Remote.IsValid() {
+			return fmt.Errorf("%w: tunnel has no remote endpoint", ErrInvalidInterface)
+		}
+		if !strings.EqualFold(i.Tunnel.Encapsulation, "GRE") && !strings.EqualFold(i.Tunnel.Encapsulation, "IPinIP") {
+			return fmt.Errorf("%w: unknown tunnel encapsulation '%s'", ErrInvalidInterface, i.Tunnel.Encapsulation)
+		}
+	}
+	return nil
+}
+
+// Prefix returns the interface's subnet, e.g. 192.0.2.0/30 for 192.0.2.1 masklen 30.
+func (i IfAddr) Prefix() netip.Prefix {
+	return netip.PrefixFrom(i.Addr, i.MaskLen).Masked()
+}
+
+// String represents the interface in RPSL format, e.g. 192.0.2.1 masklen 30.
+func (i IfAddr) String() string {
+	s := i.Addr.String() + " masklen " + strconv.Itoa(i.MaskLen)
+	if i.Tunnel != (Tunnel{}) {
+		s += " tunnel " + i.Tunnel.Remote.String() + "," + i.Tunnel.Encapsulation
+	}
+	if i.Action != "" {
+		s += " action " + i.Action
+	}
+	return s
+}
+
+// MarshalBinary encodes the interface in RPSL format.
+func (i IfAddr) MarshalBinary() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an IfAddr type.
+func (i IfAddr) UnmarshalBinary(b []byte) (IfAddr, error) {
+	return ParseIfAddr(string(b))
+}
+
+// peerProtocols are the routing protocols of RFC 2622 section 9 and RFC 4012 section 4.5.
+var peerProtocols = []string{
+	"BGP4", "MPBGP", "OSPF", "RIP", "RIPng", "IGRP", "IS-IS", "STATIC", "DVMRP", "PIM-DM", "PIM-SM",
+	"CBT", "MOSPF",
+}
+
+// Peer is an inet-rtr peer or mp-peer value, e.g. BGP4 192.0.2.2 asno(AS65001). See RFC 2622
+// section 9 and RFC 4012 section 4.5.
+type Peer struct {
+	// Routing protocol of the peering, e.g. BGP4.
+	Protocol string
+	// Address of the peer, if the peer is identified by its address.
+	Addr netip.Addr
+	// Name of the peer, if the peer is identified by an inet-rtr, rtr-set, or peering-set name.
+	Name string
+	// Protocol options, e.g. asno(AS65001).
+	Options []string
+}
+
+// ParsePeer parses an inet-rtr peer or mp-peer value, e.g. BGP4 192.0.2.2 asno(AS65001),
+// flap_damp().
+func ParsePeer(s string) (Peer, error) {
+	s = strings.TrimSpace(s)
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return Peer{}, fmt.Errorf("%w: value '%s' is not in the format '<protocol> <peer> <options>'", ErrInvalidPeer, s)
+	}
+	p := Peer{Protocol: fields[0]}
+	if addr, err := netip.ParseAddr(fields[1]); err == nil {
+		p.Addr = addr
+	} else {
+		p.Name = fields[1]
+	}
+	// Options are separated by commas, which may also appear within an option's parentheses.
+	options := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(strings.TrimPrefix(s, fields[0])), fields[1]))
+	depth, start := 0, 0
+	for i, c := range options {
+		switch {
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			p.Options = append(p.Options, strings.TrimSpace(options[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(options[start:]); last != "" {
+		p.Options = append(p.Options, last)
+	}
+	if err := p.check(0); err != nil {
+		return Peer{}, err
+	}
+	return p, nil
+}
+
+// check checks that the peer's protocol is known, that its address is of the given family (4 or 6,
+// or 0 for either), or that its name is an inet-rtr, rtr-set, or peering-set name.
+func (p Peer) check(family int) error {
+	if !slices.ContainsFunc(peerProtocols, func(proto string) bool { return strings.EqualFold(proto, p.Protocol) }) {
+		return fmt.Errorf("%w: unknown protocol '%s'", ErrInvalidPeer, p.Protocol)
+	}
+	switch {
+	case p.Addr.IsValid():
+		if (family == 4 && !p.Addr.Is4()) || (family == 6 && !p.Addr.Is6()) {
+			return fmt.Errorf("%w: %w", ErrInvalidPeer, ErrWrongFamily)
+		}
+	case hasSetComponent(p.Name, RtrSetPrefix):
+		if !isSetName(p.Name, RtrSetPrefix) {
+			return fmt.Errorf("%w: %w", ErrInvalidPeer, ErrInvalidSetName)
+		}
+	case hasSetComponent(p.Name, PeeringSetPrefix):
+		if !isSetName(p.Name, PeeringSetPrefix) {
+			return fmt.Errorf("%w: %w", ErrInvalidPeer, ErrInvalidSetName)
+		}
+	case validateDNSName("", p.Name) != nil:
+		return fmt.Errorf("%w: %w", ErrInvalidPeer, ErrInvalidDNSName)
+	}
+	for _, o := range p.Options {
+		if !strings.HasSuffix(o, ")") || !strings.Contains(o, "(") || !balanced(o) {
+			return fmt.Errorf("%w: option '%s' is not in the format 'name(arguments)'", ErrInvalidPeer, o)
+		}
+	}
+	return nil
+}
+
+// Option returns the arguments of the peer's first option with the given name, e.g. AS65001 for
+// the option asno(AS65001). The option name is case-insensitive.
+func (p Peer) Option(name string) (string, bool) {
+	for _, o := range p.Options {
+		n, args, found := strings.Cut(o, "(")
+		if found && strings.EqualFold(strings.TrimSpace(n), name) {
+			return strings.TrimSuffix(args, ")"), true
+		}
+	}
+	return "", false
+}
+
+// String represents the peer in RPSL format, e.g. BGP4 192.0.2.2 asno(AS65001).
+func (p Peer) String() string {
+	s := p.Protocol + " "
+	if p.Addr.IsValid() {
+		s += p.Addr.String()
+	} else {
+		s += p.Name
+	}
+	if len(p.Options) > 0 {
+		s += " " + strings.Join(p.Options, ", ")
+	}
+	return s
+}
+
+// MarshalBinary encodes the peer in RPSL format.
+func (p Peer) MarshalBinary() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to a Peer type.
+func (p Peer) UnmarshalBinary(b []byte) (Peer, error) {
+	return ParsePeer(string(b))
+}
+
+// InetRtr is an RPSL 'inet-rtr class' object. An inet-rtr object describes a router, its
+// interfaces, and its peerings. See RFC 2622 section 9 and RFC 4012 section 4.5.
+type InetRtr struct {
+	// DNS name of the router, e.g. rtr1.example.net.
+	//    *Required
+	InetRtr string `rpsl:"inet-rtr,mandatory"`
+	// Description for the inet-rtr object.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// Other DNS names of the router, separated by newlines.
+	Alias string `rpsl:"alias,omitempty,multiple" as:"multiline"`
+	// ASN of the AS that operates the router.
+	//    *Required
+	LocalAS ASN `rpsl:"local-as,mandatory"`
+	// IPv4 interfaces of the router.
+	IfAddr []IfAddr `rpsl:"ifaddr,omitempty,multiple" as:"multiline"`
+	// IPv4 or IPv6 interfaces of the router, which may be tunnels.
+	Interface []IfAddr `rpsl:"interface,omitempty,multiple" as:"multiline"`
+	// IPv4 peers of the router.
+	Peer []Peer `rpsl:"peer,omitempty,multiple" as:"multiline"`
+	// IPv4 or IPv6 peers of the router.
+	MPPeer []Peer `rpsl:"mp-peer,omitempty,multiple" as:"multiline"`
+	// Names of the rtr-set objects of which the router is a member.
+	MemberOf []string `rpsl:"member-of,omitempty,multiple" as:"comma-space"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the inet-rtr object. Multiple maintainers are separated by
+	// newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the inet-rtr object.
+func (r *InetRtr) AddExtra(key, value string) {
+	r.Extra.Add(key, value)
+}
+
+// String representation of the inet-rtr in RPSL format. E.g. rtr1.example.net.
+func (r *InetRtr) String() string {
+	return r.InetRtr
+}
+
+// Validate validates the inet-rtr object, returning all problems found. The inet-rtr and alias
+// values must be DNS names, at least one ifaddr or interface must be present, ifaddr and peer
+// values must be IPv4, and member-of values must be rtr-set names.
+func (r *InetRtr) Validate() error {
+	errs := validateMandatory(r)
+	if r.InetRtr != "" {
+		if err := validateDNSName("inet-rtr", r.InetRtr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, a := range splitLines(r.Alias) {
+		if err := validateDNSName("alias", a); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if r.LocalAS != 0 {
+		if err := validateOrigin("local-as", r.LocalAS); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(r.IfAddr) == 0 && len(r.Interface) == 0 {
+		err := fmt.Errorf("%w: one of ifaddr or interface is required", ErrMissingAttribute)
+		errs = append(errs, &ValidationError{Attribute: "ifaddr", Err: err})
+	}
+	for _, i := range r.IfAddr {
+		err := i.check(4)
+		if err == nil && i.Tunnel != (Tunnel{}) {
+			err = fmt.Errorf("%w: ifaddr cannot be a tunnel", ErrInvalidInterface)
+		}
+		if err != nil {
+			errs = append(errs, &ValidationError{Attribute: "ifaddr", Value: i.String(), Err: err})
+		}
+	}
+	for _, i := range r.Interface {
+		if err := i.check(0); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "interface", Value: i.String(), Err: err})
+		}
+	}
+	for _, p := range r.Peer {
+		if err := p.check(4); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "peer", Value: p.String(), Err: err})
+		}
+	}
+	for _, p := range r.MPPeer {
+		if err := p.check(0); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "mp-peer", Value: p.String(), Err: err})
+		}
+	}
+	for _, m := range r.MemberOf {
+		if err := validateSetName("member-of", m, RtrSetPrefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateContacts(r.AdminPOC, r.TechPOC, r.MntBy)...)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,230 @@
+package rpsl_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_ParseIfAddr(t *testing.T) {
+	t.Run("ifaddr", func(t *testing.T) {
+		t.Parallel()
+		i, err := rpsl.ParseIfAddr("192.0.2.1 masklen 30")
+		require.NoError(t, err)
+		assert.Equal(t, rpsl.IfAddr{Addr: netip.MustParseAddr("192.0.2.1"), MaskLen: 30}, i)
+		assert.Equal(t, netip.MustParsePrefix("192.0.2.0/30"), i.Prefix())
+		assert.Equal(t, "192.0.2.1 masklen 30", i.String())
+	})
+	t.Run("tunnel and action", func(t *testing.T) {
+		t.Parallel()
+		s := "2001:db8::1 masklen 64 tunnel 198.51.100.1,GRE action pref = 10; med = 0;"
+		i, err := rpsl.ParseIfAddr(s)
+		require.NoError(t, err)
+		assert.Equal(t, rpsl.IfAddr{
+			Addr:    netip.MustParseAddr("2001:db8::1"),
+			MaskLen: 64,
+			Tunnel:  rpsl.Tunnel{Remote: netip.MustParseAddr("198.51.100.1"), Encapsulation: "GRE"},
+			Action:  "pref = 10; med = 0;",
+		}, i)
+		assert.Equal(t, s, i.String())
+	})
+	t.Run("action keyword case", func(t *testing.T) {
+		t.Parallel()
+		i, err := rpsl.ParseIfAddr("192.0.2.1 MASKLEN 30 ACTION pref = 10;")
+		require.NoError(t, err)
+		assert.Equal(t, "pref = 10;", i.Action)
+		assert.Equal(t, "192.0.2.1 masklen 30 action pref = 10;", i.String())
+	})
+	errCases := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"no masklen", "192.0.2.1"},
+		{"invalid address", "192.0.2 masklen 24"},
+		{"invalid masklen", "192.0.2.1 masklen x"},
+		{"masklen out of range", "192.0.2.1 masklen 33"},
+		{"unknown keyword", "192.0.2.1 masklen 30 via 198.51.100.1,GRE"},
+		{"invalid tunnel", "192.0.2.1 masklen 30 tunnel 198.51.100,GRE"},
+		{"unknown encapsulation", "192.0.2.1 masklen 30 tunnel 198.51.100.1,VXLAN"},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseIfAddr(c.value)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidInterface)
+		})
+	}
+}
+
+func Test_ParsePeer(t *testing.T) {
+	t.Run("address", func(t *testing.T) {
+		t.Parallel()
+		p, err := rpsl.ParsePeer("BGP4 192.0.2.2 asno(AS65001), flap_damp(1000, 2000)")
+		require.NoError(t, err)
+		assert.Equal(t, rpsl.Peer{
+			Protocol: "BGP4",
+			Addr:     netip.MustParseAddr("192.0.2.2"),
+			Options:  []string{"asno(AS65001)", "flap_damp(1000, 2000)"},
+		}, p)
+		asno, ok := p.Option("ASNO")
+		assert.True(t, ok)
+		assert.Equal(t, "AS65001", asno)
+		_, ok = p.Option("port")
+		assert.False(t, ok)
+		assert.Equal(t, "BGP4 192.0.2.2 asno(AS65001), flap_damp(1000, 2000)", p.String())
+	})
+	t.Run("name", func(t *testing.T) {
+		t.Parallel()
+		for _, name := range []string{"rtr2.example.net", "RTRS-CORE", "AS65000:PRNG-TRANSIT"} {
+			p, err := rpsl.ParsePeer("MPBGP " + name)
+			require.NoError(t, err)
+			assert.Equal(t, rpsl.Peer{Protocol: "MPBGP", Name: name}, p)
+			assert.Equal(t, "MPBGP "+name, p.String())
+		}
+	})
+	errCases := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"no peer", "BGP4"},
+		{"unknown protocol", "EIGRP 192.0.2.2"},
+		{"invalid name", "BGP4 rtr2"},
+		{"invalid set name", "BGP4 RTRS-CORE_"},
+		{"invalid option", "BGP4 192.0.2.2 asno"},
+		{"unbalanced option", "BGP4 192.0.2.2 asno(AS65001"},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParsePeer(c.value)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidPeer)
+		})
+	}
+}
+
+func TestInetRtr_RPSL(t *testing.T) {
+	t.Parallel()
+	r := rpsl.InetRtr{
+		InetRtr:   "rtr1.example.net",
+		Alias:     "edge1.example.net",
+		LocalAS:   65000,
+		IfAddr:    []rpsl.IfAddr{{Addr: netip.MustParseAddr("192.0.2.1"), MaskLen: 30}},
+		Interface: []rpsl.IfAddr{{Addr: netip.MustParseAddr("2001:db8::1"), MaskLen: 64, Action: "pref = 10;"}},
+		Peer: []rpsl.Peer{
+			{Protocol: "BGP4", Addr: netip.MustParseAddr("192.0.2.2"), Options: []string{"asno(AS65001)"}},
+			{Protocol: "BGP4", Name: "RTRS-CORE", Options: []string{"asno(AS65000)"}},
+		},
+		MPPeer:   []rpsl.Peer{{Protocol: "MPBGP", Addr: netip.MustParseAddr("2001:db8::2"), Options: []string{"asno(AS65001)"}}},
+		MemberOf: []string{"RTRS-EDGE"},
+		MntBy:    "MNT-ACME",
+		Source:   "TEST",
+	}
+	exp := []byte(`inet-rtr: rtr1.example.net
+alias: edge1.example.net
+local-as: AS65000
+ifaddr: 192.0.2.1 masklen 30
+interface: 2001:db8::1 masklen 64 action pref = 10;
+peer: BGP4 192.0.2.2 asno(AS65001)
+peer: BGP4 RTRS-CORE asno(AS65000)
+mp-peer: MPBGP 2001:db8::2 asno(AS65001)
+member-of: RTRS-EDGE
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&r)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "rtr1.example.net", r.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.InetRtr
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, r, decoded)
+	})
+	t.Run("decode error", func(t *testing.T) {
+		var decoded rpsl.InetRtr
+		err := rpsl.UnmarshalBinary([]byte("inet-rtr: rtr1.example.net\nlocal-as: AS65000\nifaddr: 192.0.2.1"), &decoded)
+		assert.ErrorIs(t, err, rpsl.ErrInvalidInterface)
+	})
+}
+
+func TestInetRtr_Validate(t *testing.T) {
+	ifaddr := []rpsl.IfAddr{{Addr: netip.MustParseAddr("192.0.2.1"), MaskLen: 30}}
+	cases := []struct {
+		name string
+		r    rpsl.InetRtr
+		err  error
+	}{
+		{"valid", rpsl.InetRtr{
+			InetRtr:   "rtr1.example.net",
+			Alias:     "edge1.example.net\nedge1.example.com",
+			LocalAS:   65000,
+			IfAddr:    ifaddr,
+			Interface: []rpsl.IfAddr{{Addr: netip.MustParseAddr("2001:db8::1"), MaskLen: 64}},
+			Peer:      []rpsl.Peer{{Protocol: "BGP4", Addr: netip.MustParseAddr("192.0.2.2")}},
+			MPPeer:    []rpsl.Peer{{Protocol: "MPBGP", Addr: netip.MustParseAddr("2001:db8::2")}},
+			MemberOf:  []string{"AS65000:RTRS-EDGE"},
+		}, nil},
+		{"interface only", rpsl.InetRtr{
+			InetRtr:   "rtr1.example.net",
+			LocalAS:   65000,
+			Interface: []rpsl.IfAddr{{Addr: netip.MustParseAddr("2001:db8::1"), MaskLen: 64}},
+		}, nil},
+		{"missing local-as", rpsl.InetRtr{InetRtr: "rtr1.example.net", IfAddr: ifaddr}, rpsl.ErrMissingAttribute},
+		{"missing ifaddr", rpsl.InetRtr{InetRtr: "rtr1.example.net", LocalAS: 65000}, rpsl.ErrMissingAttribute},
+		{"invalid name", rpsl.InetRtr{InetRtr: "rtr1", LocalAS: 65000, IfAddr: ifaddr}, rpsl.ErrInvalidDNSName},
+		{"invalid alias", rpsl.InetRtr{InetRtr: "rtr1.example.net", Alias: "edge_1.example.net", LocalAS: 65000, IfAddr: ifaddr}, rpsl.ErrInvalidDNSName},
+		{"reserved local-as", rpsl.InetRtr{InetRtr: "rtr1.example.net", LocalAS: 23456, IfAddr: ifaddr}, rpsl.ErrReservedASN},
+		{"ipv6 ifaddr", rpsl.InetRtr{
+			InetRtr: "rtr1.example.net",
+			LocalAS: 65000,
+			IfAddr:  []rpsl.IfAddr{{Addr: netip.MustParseAddr("2001:db8::1"), MaskLen: 64}},
+		}, rpsl.ErrWrongFamily},
+		{"ifaddr tunnel", rpsl.InetRtr{
+			InetRtr: "rtr1.example.net",
+			LocalAS: 65000,
+			IfAddr: []rpsl.IfAddr{{
+				Addr:    netip.MustParseAddr("192.0.2.1"),
+				MaskLen: 30,
+				Tunnel:  rpsl.Tunnel{Remote: netip.MustParseAddr("198.51.100.1"), Encapsulation: "GRE"},
+			}},
+		}, rpsl.ErrInvalidInterface},
+		{"interface masklen", rpsl.InetRtr{
+			InetRtr:   "rtr1.example.net",
+			LocalAS:   65000,
+			Interface: []rpsl.IfAddr{{Addr: netip.MustParseAddr("2001:db8::1"), MaskLen: 129}},
+		}, rpsl.ErrInvalidInterface},
+		{"ipv6 peer", rpsl.InetRtr{
+			InetRtr: "rtr1.example.net",
+			LocalAS: 65000,
+			IfAddr:  ifaddr,
+			Peer:    []rpsl.Peer{{Protocol: "BGP4", Addr: netip.MustParseAddr("2001:db8::2")}},
+		}, rpsl.ErrWrongFamily},
+		{"invalid mp-peer", rpsl.InetRtr{
+			InetRtr: "rtr1.example.net",
+			LocalAS: 65000,
+			IfAddr:  ifaddr,
+			MPPeer:  []rpsl.Peer{{Protocol: "EIGRP", Addr: netip.MustParseAddr("2001:db8::2")}},
+		}, rpsl.ErrInvalidPeer},
+		{"invalid member-of", rpsl.InetRtr{InetRtr: "rtr1.example.net", LocalAS: 65000, IfAddr: ifaddr, MemberOf: []string{"RS-EDGE"}}, rpsl.ErrInvalidSetName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.r.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
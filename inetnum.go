@@ -0,0 +1,97 @@
+package rpsl
+
+import (
+	"errors"
+	"net/netip"
+)
+
+// Inetnum is an RPSL 'inetnum class' object. An inetnum object records the allocation or
+// assignment of a range of IPv4 addresses, which route objects for the addresses should be covered
+// by.
+type Inetnum struct {
+	// Range of IPv4 addresses, e.g. 192.0.2.0 - 192.0.2.255.
+	//    *Required
+	Inetnum IPv4Range `rpsl:"inetnum,mandatory"`
+	// Attributes of the network to which the addresses are allocated or assigned.
+	Network
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Network holds the attributes shared by the inetnum and inet6num classes, which describe the
+// network to which a range of addresses is allocated or assigned, and its contacts and maintainers.
+type Network struct {
+	// Name of the network, e.g. ACME-NET.
+	//    *Required
+	NetName string `rpsl:"netname,mandatory"`
+	// Description for the network.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// ISO 3166-1 alpha-2 country codes of the network's location. Multiple codes are separated by
+	// newlines.
+	Country string `rpsl:"country,omitempty,multiple" as:"multiline"`
+	// Organisation object of the holder of the addresses, e.g. ORG-ACME1-RIPE.
+	Org string `rpsl:"org,omitempty"`
+	// Registry-specific status of the addresses, e.g. ASSIGNED PA or ALLOCATED-BY-RIR.
+	Status string `rpsl:"status,omitempty"`
+	// Maintainer object, which authorizes the creation of more specific inetnum or inet6num
+	// objects. Multiple maintainers are separated by newlines.
+	MntLower string `rpsl:"mnt-lower,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which authorizes the creation of route or route6 objects for the
+	// addresses. Multiple maintainers are separated by newlines.
+	MntRoutes string `rpsl:"mnt-routes,omitempty,multiple" as:"multiline"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// E-mail addresses notified of updates to the object. Multiple addresses are separated by
+	// newlines.
+	Notify string `rpsl:"notify,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the object. Multiple maintainers are separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+}
+
+// validate checks the network attributes, returning all problems found. The netname, contacts, and
+// maintainers must be valid object names, country values must be country codes, and notify values
+// must be e-mail addresses.
+func (n *Network) validate() []error {
+	errs := validateNames("netname", n.NetName)
+	errs = append(errs, validateCountries("country", n.Country)...)
+	errs = append(errs, validateNames("mnt-lower", n.MntLower)...)
+	errs = append(errs, validateNames("mnt-routes", n.MntRoutes)...)
+	errs = append(errs, validateEmails("notify", n.Notify)...)
+	return append(errs, validateContacts(n.AdminPOC, n.TechPOC, n.MntBy)...)
+}
+
+// Add extra pre-formatted attributes to the inetnum object.
+func (n *Inetnum) AddExtra(key, value string) {
+	n.Extra.Add(key, value)
+}
+
+// String representation of the inetnum in RPSL format. E.g. 192.0.2.0 - 192.0.2.255.
+func (n *Inetnum) String() string {
+	return n.Inetnum.String()
+}
+
+// Covers determines if a prefix, e.g. that of a route object, is entirely within the inetnum.
+func (n *Inetnum) Covers(p netip.Prefix) bool {
+	return n.Inetnum.ContainsPrefix(p)
+}
+
+// Validate validates the inetnum object, returning all problems found. The inetnum must be a valid
+// IPv4 range, the netname must be a valid object name, and country values must be country codes.
+func (n *Inetnum) Validate() error {
+	errs := validateMandatory(n)
+	if r := n.Inetnum; r != (IPv4Range{}) {
+		if err := r.check(); err != nil {
+			// An invalid range has no RPSL representation, so its addresses are reported as-is.
+			value := r.First.String() + " - " + r.Last.String()
+			errs = append(errs, &ValidationError{Attribute: "inetnum", Value: value, Err: err})
+		}
+	}
+	errs = append(errs, n.Network.validate()...)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,88 @@
+package rpsl_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestInetnum_RPSL(t *testing.T) {
+	t.Parallel()
+	n := rpsl.Inetnum{
+		Inetnum: rpsl.MustParseIPv4Range("192.0.2.0 - 192.0.2.255"),
+		Network: rpsl.Network{
+			NetName:   "ACME-NET",
+			Country:   "US",
+			Org:       "ORG-ACME1-TEST",
+			Status:    "ASSIGNED PA",
+			MntRoutes: "MNT-ACME",
+		},
+		Source: "TEST",
+	}
+	exp := []byte(`inetnum: 192.0.2.0 - 192.0.2.255
+netname: ACME-NET
+country: US
+org: ORG-ACME1-TEST
+status: ASSIGNED PA
+mnt-routes: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&n)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "192.0.2.0 - 192.0.2.255", n.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.Inetnum
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, n, decoded)
+	})
+	t.Run("decode prefix", func(t *testing.T) {
+		var decoded rpsl.Inetnum
+		err := rpsl.UnmarshalBinary([]byte("inetnum: 192.0.2.0/24\nnetname: ACME-NET"), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, n.Inetnum, decoded.Inetnum)
+	})
+	t.Run("covers", func(t *testing.T) {
+		r := rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/25")}
+		assert.True(t, n.Covers(r.Route.Prefix))
+		assert.False(t, n.Covers(netip.MustParsePrefix("192.0.2.0/23")))
+	})
+}
+
+func TestInetnum_Validate(t *testing.T) {
+	r := rpsl.MustParseIPv4Range("192.0.2.0 - 192.0.2.255")
+	net := rpsl.Network{NetName: "ACME-NET"}
+	cases := []struct {
+		name    string
+		inetnum rpsl.Inetnum
+		err     error
+	}{
+		{"valid", rpsl.Inetnum{Inetnum: r, Network: rpsl.Network{NetName: "ACME-NET", Country: "US\nCA", MntLower: "MNT-ACME"}}, nil},
+		{"missing inetnum", rpsl.Inetnum{Network: net}, rpsl.ErrMissingAttribute},
+		{"missing netname", rpsl.Inetnum{Inetnum: r}, rpsl.ErrMissingAttribute},
+		{"inverted range", rpsl.Inetnum{Inetnum: rpsl.IPv4Range{First: r.Last, Last: r.First}, Network: net}, rpsl.ErrInvalidRange},
+		{"invalid netname", rpsl.Inetnum{Inetnum: r, Network: rpsl.Network{NetName: "ACME NET"}}, rpsl.ErrInvalidName},
+		{"invalid country", rpsl.Inetnum{Inetnum: r, Network: rpsl.Network{NetName: "ACME-NET", Country: "USA"}}, rpsl.ErrInvalidCountry},
+		{"invalid mnt-routes", rpsl.Inetnum{Inetnum: r, Network: rpsl.Network{NetName: "ACME-NET", MntRoutes: "MNT ACME"}}, rpsl.ErrInvalidName},
+		{"invalid admin-c", rpsl.Inetnum{Inetnum: r, Network: rpsl.Network{NetName: "ACME-NET", AdminPOC: "JD 1"}}, rpsl.ErrInvalidName},
+		{"invalid notify", rpsl.Inetnum{Inetnum: r, Network: rpsl.Network{NetName: "ACME-NET", Notify: "noc"}}, rpsl.ErrInvalidEmail},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.inetnum.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package serialize
+
+import (
+	"slices"
+	"strings"
+)
+
+// Attribute is a single RPSL attribute, i.e. a key/value pair.
+type Attribute struct {
+	// Attribute name, e.g. remarks.
+	Key string
+	// Attribute value.
+	Value string
+	// Attribute that preceded this attribute in the object from which it was decoded, or nil if
+	// the attribute was not decoded. Decoded attributes are encoded after the same attribute, so
+	// that changes to the object's other attributes do not move them.
+	After *Anchor
+}
+
+// Anchor identifies an attribute of an object by its key and its one-based occurrence among the
+// object's attributes with that key, e.g. the second descr. An occurrence of 0 identifies the last
+// attribute with that key, and an empty key identifies the start of the object.
+type Anchor struct {
+	// Attribute name, e.g. descr.
+	Key string
+	// One-based occurrence of the attribute, or 0 for the last.
+	N int
+}
+
+// Attributes is an ordered list of RPSL attributes. Keys may be repeated, and are matched regardless
+// of case, as attribute names are case-insensitive. Order is retained when encoding. Decoded
+// attributes keep their place after the attribute that preceded them, e.g. after source; other
+// attributes are encoded where the list's field is declared.
+type Attributes []Attribute
+
+// Get returns the value of the first attribute matching key, or an empty string if no attribute
+// matches.
+func (a Attributes) Get(key string) string {
+	for _, attr := range a {
+		if strings.EqualFold(attr.Key, key) {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// GetAll returns the values of all attributes matching key, in order.
+func (a Attributes) GetAll(key string) []string {
+	var out []string
+	for _, attr := range a {
+		if strings.EqualFold(attr.Key, key) {
+			out = append(out, attr.Value)
+		}
+	}
+	return out
+}
+
+// Add appends an attribute to the end of the list.
+func (a *Attributes) Add(key, value string) {
+	*a = append(*a, Attribute{Key: key, Value: value})
+}
+
+// Set sets the value of the first attribute matching key and removes any other attributes
+// matching key. If no attribute matches key, the attribute is appended to the end of the list.
+func (a *Attributes) Set(key, value string) {
+	for i, attr := range *a {
+		if strings.EqualFold(attr.Key, key) {
+			(*a)[i].Value = value
+			*a = slices.Concat((*a)[:i+1], slices.DeleteFunc((*a)[i+1:], func(attr Attribute) bool {
+				return strings.EqualFold(attr.Key, key)
+			}))
+			return
+		}
+	}
+	a.Add(key, value)
+}
+
+// Delete removes all attributes matching key.
+func (a *Attributes) Delete(key string) {
+	*a = slices.DeleteFunc(*a, func(attr Attribute) bool {
+		return strings.EqualFold(attr.Key, key)
+	})
+}
@@ -0,0 +1,85 @@
+package serialize_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+func Test_Attributes(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		t.Parallel()
+		attrs := serialize.Attributes{
+			{Key: "remarks", Value: "one"},
+			{Key: "remarks", Value: "two"},
+		}
+		assert.Equal(t, "one", attrs.Get("remarks"))
+		assert.Equal(t, "", attrs.Get("notify"))
+	})
+	t.Run("get all", func(t *testing.T) {
+		t.Parallel()
+		attrs := serialize.Attributes{
+			{Key: "remarks", Value: "one"},
+			{Key: "notify", Value: "noc@example.com"},
+			{Key: "remarks", Value: "two"},
+		}
+		assert.Equal(t, []string{"one", "two"}, attrs.GetAll("remarks"))
+		assert.Nil(t, attrs.GetAll("descr"))
+	})
+	t.Run("mixed case", func(t *testing.T) {
+		t.Parallel()
+		attrs := serialize.Attributes{
+			{Key: "Remarks", Value: "one"},
+			{Key: "notify", Value: "noc@example.com"},
+			{Key: "REMARKS", Value: "two"},
+		}
+		assert.Equal(t, "one", attrs.Get("remarks"))
+		assert.Equal(t, []string{"one", "two"}, attrs.GetAll("Remarks"))
+		attrs.Set("remarks", "three")
+		assert.Equal(t, serialize.Attributes{
+			{Key: "Remarks", Value: "three"},
+			{Key: "notify", Value: "noc@example.com"},
+		}, attrs)
+		attrs.Delete("NOTIFY")
+		assert.Equal(t, serialize.Attributes{{Key: "Remarks", Value: "three"}}, attrs)
+	})
+	t.Run("add", func(t *testing.T) {
+		t.Parallel()
+		var attrs serialize.Attributes
+		attrs.Add("remarks", "one")
+		attrs.Add("remarks", "two")
+		exp := serialize.Attributes{
+			{Key: "remarks", Value: "one"},
+			{Key: "remarks", Value: "two"},
+		}
+		assert.Equal(t, exp, attrs)
+	})
+	t.Run("set", func(t *testing.T) {
+		t.Parallel()
+		attrs := serialize.Attributes{
+			{Key: "remarks", Value: "one"},
+			{Key: "notify", Value: "noc@example.com"},
+			{Key: "remarks", Value: "two"},
+		}
+		attrs.Set("remarks", "three")
+		exp := serialize.Attributes{
+			{Key: "remarks", Value: "three"},
+			{Key: "notify", Value: "noc@example.com"},
+		}
+		assert.Equal(t, exp, attrs)
+		attrs.Set("source", "ARIN")
+		assert.Equal(t, serialize.Attribute{Key: "source", Value: "ARIN"}, attrs[2])
+	})
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+		attrs := serialize.Attributes{
+			{Key: "remarks", Value: "one"},
+			{Key: "notify", Value: "noc@example.com"},
+			{Key: "remarks", Value: "two"},
+		}
+		attrs.Delete("remarks")
+		exp := serialize.Attributes{{Key: "notify", Value: "noc@example.com"}}
+		assert.Equal(t, exp, attrs)
+	})
+}
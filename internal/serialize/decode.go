@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -24,12 +25,125 @@ func ProcessString(current string, _new []byte, sep string) string {
 
 func ProcessStringSlice(current reflect.Value, _new []byte, sep string) reflect.Value {
 	parts := strings.Split(string(_new), sep)
-	return reflect.AppendSlice(current, reflect.ValueOf(parts))
+	cleanParts := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			cleanParts = append(cleanParts, p)
+		}
+	}
+	return reflect.AppendSlice(current, reflect.ValueOf(cleanParts))
+}
+
+// IsContinuation determines if a line is an RFC 2622 continuation line, i.e. a line beginning with
+// a space, tab, or '+' character.
+func IsContinuation(line []byte) bool {
+	if len(line) == 0 {
+		return false
+	}
+	switch line[0] {
+	case 0x20, 0x9, 0x2b:
+		return true
+	}
+	return false
+}
+
+// IsComment determines if a line is a comment line, i.e. a line beginning with '#' (RFC 2622) or
+// '%' (used by whois servers).
+func IsComment(line []byte) bool {
+	return len(line) > 0 && (line[0] == 0x23 || line[0] == 0x25)
+}
+
+// pair is a single key/value pair parsed from an RPSL blob, along with its position.
+type pair struct {
+	key   []byte
+	value []byte
+	// 1-based line number of the pair's first line.
+	line int
+	// 1-based column at which the pair's value begins.
+	column int
+}
+
+// parsePairs separates an RPSL blob into key/value pairs. Continuation lines are folded into the
+// preceding attribute's value, separated by '\n'. Empty continuation lines, e.g. a lone '+', are
+// kept as blank lines.
+func parsePairs(b []byte) []pair {
+	// Normalize line endings & trim trailing newlines. Leading newlines are retained so that line
+	// numbers are accurate.
+	b = bytes.ReplaceAll(b, []byte{0xd, 0xa}, []byte{0xa})
+	b = bytes.TrimRight(b, "\n")
+	// Separate full blob by lines.
+	blines := bytes.Split(b, []byte{0xa})
+
+	// Create a slice of key/value pairs.
+	pairs := make([]pair, 0, len(blines))
+	for i := range blines {
+		if IsComment(blines[i]) {
+			continue
+		}
+		if IsContinuation(blines[i]) {
+			if len(pairs) == 0 {
+				// A continuation line without a preceding attribute has nothing to continue.
+				continue
+			}
+			// Remove the '+' continuation character, if present.
+			cont := bytes.TrimSpace(bytes.TrimPrefix(blines[i], []byte{0x2b}))
+			last := &pairs[len(pairs)-1]
+			last.value = bytes.Join([][]byte{last.value, cont}, []byte{0xa})
+			continue
+		}
+		// Split each line on the first ':' only, as values may contain ':' characters (e.g. IPv6
+		// prefixes or timestamps).
+		key, rawValue, found := bytes.Cut(blines[i], []byte{0x3a})
+		if !found {
+			// If no ':' character found, skip this line
+			continue
+		}
+		// Trim any surrounding whitespace on value.
+		value := bytes.TrimSpace(rawValue)
+		// The value begins after the key, the ':' character, and any leading whitespace.
+		column := len(key) + 1 + len(rawValue) - len(bytes.TrimLeft(rawValue, " \t")) + 1
+		// Add pair to k/v pair slice, trimming any surrounding whitespace on key.
+		pairs = append(pairs, pair{key: bytes.TrimSpace(key), value: value, line: i + 1, column: column})
+	}
+	return pairs
+}
+
+// fold joins the non-blank lines of a folded (continued) value with a single space, unless the
+// value is destined for a multiline or block field, in which case each line is retained. Values of
+// repeated fields, e.g. import, are joined, as each attribute holds a single expression.
+func fold(value []byte, as string) []byte {
+	if as == "multiline" || as == "block" {
+		return value
+	}
+	lines := bytes.Split(value, []byte{0xa})
+	lines = slices.DeleteFunc(lines, func(line []byte) bool { return len(line) == 0 })
+	return bytes.Join(lines, []byte{0x20})
+}
+
+// Options configures how RPSL objects are decoded.
+type Options struct {
+	// AllErrors causes all errors in an object to be collected and returned together, rather than
+	// stopping at the first error.
+	AllErrors bool
+	// Object is the zero-based index of the object within a stream, used in error reporting.
+	Object int
+	// Line is the number of lines preceding the object within a stream, used in error reporting.
+	Line int
+	// Strict causes objects with missing mandatory attributes, repeated single-valued attributes,
+	// or unknown attributes to be rejected. Unknown attributes are not added to the 'Extra' field.
+	// Structs without any typed fields (e.g. generic objects) accept any attribute.
+	Strict bool
 }
 
 // Decode decodes a byte string of RPSL data to a Go RPSL object.
 // The second argument must be a pointer to an RPSL struct.
 func Decode(b []byte, o any) error {
+	return DecodeWithOptions(b, o, Options{})
+}
+
+// DecodeWithOptions decodes a byte string of RPSL data to a Go RPSL object, using the given
+// options. The second argument must be a pointer to an RPSL struct.
+func DecodeWithOptions(b []byte, o any, opts Options) error {
 	to := reflect.TypeOf(o)
 	rv := reflect.ValueOf(o)
 	// Ensure passed value is a non-nil pointer.
@@ -44,72 +158,81 @@ func Decode(b []byte, o any) error {
 	}
 	// Retrieve field value's value.
 	rvElem := rv.Elem()
-	// Trim leading & trailing newlines.
-	b = bytes.Trim(b, "\n")
-	// Separate full blob by lines.
-	blines := bytes.Split(b, []byte{0xa})
-
-	// Create a slice of key/value pairs.
-	pairs := make([][][]byte, 0, len(blines))
-	for i := range blines {
-		// Split each line by ':'
-		pairline := bytes.Split(blines[i], []byte{0x3a})
-		if len(pairline) < 2 {
-			// If no ':' character found, skip this line
-			continue
+	// Parse the blob into key/value pairs.
+	pairs := parsePairs(b)
+	// Collect attribute names claimed by typed fields, so that only unknown attributes are added
+	// to the 'Extra' field.
+	known := knownKeys(rt)
+	// Anchor each attribute to the attribute preceding it, so that any 'Extra' attributes keep
+	// their place when the object is encoded.
+	anchors := anchorPairs(pairs)
+	// Collect errors, ordered by their position in the object.
+	var errs []error
+	fail := func(p pair, err error) error {
+		synErr := &SyntaxError{
+			Object:    opts.Object,
+			Line:      opts.Line + p.line,
+			Column:    p.column,
+			Attribute: string(p.key),
+			Value:     string(fold(p.value, "")),
+			Err:       err,
 		}
-		// Trim any surrounding whitespace on key.
-		key := bytes.TrimSpace(pairline[0])
-		// Trim any surrounding whitespace on value.
-		value := bytes.TrimSpace(
-			// Join remaining characters (accounts for ':' in value)
-			bytes.Join(pairline[1:], []byte{0x0}),
-		)
-		// Add pair to k/v pair slice.
-		pairs = append(pairs, [][]byte{key, value})
-	}
-	for i := range rt.NumField() {
-		// Retrieve struct field.
-		field := rt.Field(i)
+		errs = append(errs, synErr)
+		if opts.AllErrors {
+			return nil
+		}
+		return synErr
+	}
+	// Fields of embedded structs are decoded as if they were fields of the struct itself.
+	for _, field := range reflect.VisibleFields(rt) {
 		// Retrieve struct field's value.
-		valueField := rvElem.Field(i)
+		valueField := rvElem.FieldByIndex(field.Index)
 
 		tag := field.Tag.Get("rpsl")
 		if tag == "" {
 			// If no rpsl tag is present, skip this field (hidden or irrelevant field).
 			continue
 		}
-		// Get rpsl struct tag value, ignoring any ',omitempty' tags.
+		// Get rpsl struct tag value, ignoring any options, e.g. ',omitempty'.
 		tags := strings.Split(tag, ",")
 		keyName := tags[0]
 
 		as, hasAs := field.Tag.Lookup("as")
 
 		// Begin struct field to key/pair matching.
-		for _, pair := range pairs {
-			key := pair[0]   // left side of first ':', key
-			value := pair[1] // right side of first ':', value
+		for pos, pair := range pairs {
+			key := pair.key               // left side of first ':', key
+			value := fold(pair.value, as) // right side of first ':', value
 
-			// Add extra values to the 'Extra' field map, which is tagged as "-".
+			// Add extra values to the 'Extra' field attribute list, which is tagged as "-".
 			if keyName == "-" {
-				m := valueField.Interface().(map[string]string)
-				if m == nil {
-					// Initialize map if this is the first pass.
-					m = make(map[string]string)
+				if _, isKnown := known[strings.ToLower(string(key))]; isKnown {
+					continue
 				}
-				m[string(key)] = string(value)
-				// Reassign 'Extra' map.
-				valueField.Set(reflect.ValueOf(m))
+				if opts.Strict && len(known) > 0 {
+					// Unknown attributes are rejected by strictCheck.
+					continue
+				}
+				attrs := valueField.Interface().(Attributes)
+				attrs = append(attrs, Attribute{Key: string(key), Value: string(value), After: &anchors[pos]})
+				// Reassign 'Extra' attributes.
+				valueField.Set(reflect.ValueOf(attrs))
 				continue
 			}
-			// Struct field matches this k/v pair.
-			if keyName == string(key) {
+			// Struct field matches this k/v pair. Attribute names are case-insensitive.
+			if strings.EqualFold(keyName, string(key)) {
 				if hasAs {
 					switch valueType := valueField.Interface().(type) {
 					case string:
 						switch as {
-						case "multiline":
+						case "multiline", "repeated":
 							valueField.SetString(ProcessString(valueType, value, "\n"))
+						case "block":
+							// Block values keep blank lines, e.g. in an armored key.
+							if valueType != "" {
+								value = append([]byte(valueType+"\n"), value...)
+							}
+							valueField.SetString(string(value))
 						case "comma-space":
 							valueField.SetString(ProcessString(valueType, value, ", "))
 						case "comma":
@@ -117,13 +240,29 @@ func Decode(b []byte, o any) error {
 						}
 					case []string:
 						switch as {
-						case "multiline":
+						case "multiline", "repeated":
+							// Each line may itself be a comma-separated list, e.g. members: AS1, AS2.
+							value = bytes.ReplaceAll(value, []byte(","), []byte("\n"))
 							valueField.Set(ProcessStringSlice(valueField, value, "\n"))
-						case "comma-space":
-							valueField.Set(ProcessStringSlice(valueField, value, ", "))
-						case "comma":
+						case "comma-space", "comma":
+							// Items are trimmed, so both separators accept either form.
 							valueField.Set(ProcessStringSlice(valueField, value, ","))
 						}
+					default:
+						// Slices of types with a valid UnmarshalBinary method are decoded element by
+						// element.
+						if valueField.Kind() != reflect.Slice {
+							continue
+						}
+						// Each attribute is a single element, so its lines are always joined.
+						elems, err := unmarshalSlice(valueField.Type(), fold(pair.value, ""), separator(as))
+						if err != nil {
+							if err := fail(pair, err); err != nil {
+								return err
+							}
+							continue
+						}
+						valueField.Set(reflect.AppendSlice(valueField, elems))
 					}
 				} else {
 					// If the value is a type that has a valid UnmarshalBinary method, call that method
@@ -134,9 +273,10 @@ func Decode(b []byte, o any) error {
 						// Check and coerce to error if error is non-nil.
 						maybeErr := result[1].Interface()
 						if maybeErr != nil {
-							err := maybeErr.(error)
-							err = errors.Join(fmt.Errorf("%s: failed to unmarshal", keyName), err)
-							return err
+							if err := fail(pair, maybeErr.(error)); err != nil {
+								return err
+							}
+							continue
 						}
 						// Assign return value from UnmarshalBinary to struct field.
 						valueField.Set(result[0])
@@ -148,10 +288,12 @@ func Decode(b []byte, o any) error {
 						valueField.SetString(string(value))
 						continue
 					case reflect.Uint32:
-						u, err := strconv.ParseUint(string(value), 10, 64)
+						u, err := strconv.ParseUint(string(value), 10, 32)
 						if err != nil {
-							err := errors.Join(fmt.Errorf("%s: value could not be parsed as uint32", keyName), err)
-							return err
+							if err := fail(pair, fmt.Errorf("value could not be parsed as uint32: %w", err)); err != nil {
+								return err
+							}
+							continue
 						}
 						valueField.SetUint(u)
 						continue
@@ -160,5 +302,139 @@ func Decode(b []byte, o any) error {
 			}
 		}
 	}
-	return nil
+	if opts.Strict {
+		for _, check := range strictCheck(rt, pairs, known) {
+			if err := fail(check.pair, check.err); err != nil {
+				return err
+			}
+		}
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	slices.SortStableFunc(errs, func(a, b error) int {
+		return a.(*SyntaxError).Line - b.(*SyntaxError).Line
+	})
+	return errors.Join(errs...)
+}
+
+// anchorPairs returns the anchor of the attribute preceding each pair. A preceding attribute that
+// is the last with its key is anchored as such, so that attributes added to it are not split.
+func anchorPairs(pairs []pair) []Anchor {
+	totals := make(map[string]int, len(pairs))
+	for _, p := range pairs {
+		totals[strings.ToLower(string(p.key))]++
+	}
+	anchors := make([]Anchor, len(pairs))
+	counts := make(map[string]int, len(pairs))
+	for i := 1; i < len(pairs); i++ {
+		key := strings.ToLower(string(pairs[i-1].key))
+		counts[key]++
+		anchors[i] = Anchor{Key: key, N: counts[key]}
+		if counts[key] == totals[key] {
+			anchors[i].N = 0
+		}
+	}
+	return anchors
+}
+
+// separator returns the value separator for an 'as' tag value. Multiline values are not separated
+// within a single attribute. Parts are trimmed, so comma-space values are separated by commas alone.
+func separator(as string) string {
+	switch as {
+	case "comma-space", "comma":
+		return ","
+	}
+	return ""
+}
+
+// unmarshalSlice decodes a value to a slice of a type with a valid UnmarshalBinary method, splitting
+// the value by sep if it is non-empty. Empty parts are skipped.
+func unmarshalSlice(st reflect.Type, value []byte, sep string) (reflect.Value, error) {
+	parts := [][]byte{value}
+	if sep != "" {
+		parts = bytes.Split(value, []byte(sep))
+	}
+	out := reflect.MakeSlice(st, 0, len(parts))
+	method := reflect.New(st.Elem()).Elem().MethodByName("UnmarshalBinary")
+	if !method.IsValid() {
+		return out, nil
+	}
+	for _, part := range parts {
+		if part = bytes.TrimSpace(part); len(part) == 0 {
+			continue
+		}
+		result := method.Call([]reflect.Value{reflect.ValueOf(part)})
+		if maybeErr := result[1].Interface(); maybeErr != nil {
+			return out, maybeErr.(error)
+		}
+		out = reflect.Append(out, result[0])
+	}
+	return out, nil
+}
+
+// violation is an attribute that fails a strict decoding check.
+type violation struct {
+	pair pair
+	err  error
+}
+
+// strictCheck checks parsed pairs against the attribute specs of a struct type, returning any
+// missing mandatory attributes, repeated single-valued attributes, and unknown attributes.
+func strictCheck(rt reflect.Type, pairs []pair, known map[string]struct{}) []violation {
+	if len(known) == 0 {
+		return nil
+	}
+	var violations []violation
+	counts := make(map[string]int, len(pairs))
+	for _, p := range pairs {
+		key := strings.ToLower(string(p.key))
+		if _, isKnown := known[key]; !isKnown {
+			violations = append(violations, violation{p, ErrUnknownAttribute})
+		}
+		counts[key]++
+	}
+	for _, spec := range Specs(rt) {
+		key := strings.ToLower(spec.Key)
+		if spec.Mandatory && counts[key] == 0 {
+			// Missing attributes are reported at the position of the object's first attribute.
+			missing := pair{key: []byte(spec.Key), line: 1, column: 1}
+			if len(pairs) > 0 {
+				missing.line = pairs[0].line
+			}
+			violations = append(violations, violation{missing, ErrMissingAttribute})
+		}
+		if !spec.Multiple && counts[key] > 1 {
+			seen := 0
+			for _, p := range pairs {
+				if strings.EqualFold(spec.Key, string(p.key)) {
+					if seen > 0 {
+						violations = append(violations, violation{p, ErrDuplicateAttribute})
+					}
+					seen++
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// knownKeys collects the attribute names of all tagged fields of a struct type.
+func knownKeys(rt reflect.Type) map[string]struct{} {
+	specs := Specs(rt)
+	known := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		known[strings.ToLower(spec.Key)] = struct{}{}
+	}
+	return known
+}
+
+// Class returns the class name of an RPSL object, which is the key of the object's first
+// attribute. If the object has no attributes, an empty string is returned.
+func Class(b []byte) string {
+	pairs := parsePairs(b)
+	if len(pairs) == 0 {
+		return ""
+	}
+	return strings.ToLower(string(pairs[0].key))
 }
@@ -84,6 +84,51 @@ members: AS-65001`)
 		exp := []string{"AS65000", "AS-65001"}
 		assert.Equal(t, exp, asSet.Members)
 	})
+	t.Run("with as block", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1 string `rpsl:"key1"`
+			Key2 string `rpsl:"key2" as:"block"`
+		}
+		b := []byte(`key1: value1
+key2: value2-1
+key2:
+key2: value2-2`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		require.NoError(t, err)
+		assert.Equal(t, "value2-1\n\nvalue2-2", s.Key2)
+	})
+	t.Run("with as block continuation", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1 string `rpsl:"key1"`
+			Key2 string `rpsl:"key2" as:"block"`
+		}
+		b := []byte("key1: value1\nkey2: value2-1\n+\n value2-2\nkey2: value2-3")
+		var s Struct
+		err := serialize.Decode(b, &s)
+		require.NoError(t, err)
+		assert.Equal(t, "value2-1\n\nvalue2-2\nvalue2-3", s.Key2)
+	})
+	t.Run("embedded", func(t *testing.T) {
+		t.Parallel()
+		type Embedded struct {
+			Key2 string `rpsl:"key2" as:"multiline"`
+		}
+		type Struct struct {
+			Key1 string `rpsl:"key1"`
+			Embedded
+			Extra serialize.Attributes `rpsl:"-"`
+		}
+		b := []byte("key1: value1\nkey2: value2-1\nkey2: value2-2\nkey3: value3")
+		var s Struct
+		err := serialize.Decode(b, &s)
+		require.NoError(t, err)
+		assert.Equal(t, "value1", s.Key1)
+		assert.Equal(t, "value2-1\nvalue2-2", s.Key2)
+		assert.Equal(t, serialize.Attributes{{Key: "key3", Value: "value3", After: &serialize.Anchor{Key: "key2"}}}, s.Extra)
+	})
 	t.Run("with extra", func(t *testing.T) {
 		t.Parallel()
 		b := []byte(`as-set: AS-ACME
@@ -93,8 +138,258 @@ extra1: value1`)
 		var asSet rpsl.ASSet
 		err := serialize.Decode(b, &asSet)
 		require.NoError(t, err)
-		assert.Equal(t, "value1", asSet.Extra["extra1"])
-
+		exp := serialize.Attributes{{Key: "extra1", Value: "value1", After: &serialize.Anchor{Key: "members"}}}
+		assert.Equal(t, exp, asSet.Extra)
+	})
+	t.Run("with repeated extra", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`as-set: AS-ACME
+remarks-b: one
+members: AS65000
+remarks-a: two
+remarks-b: three`)
+		var asSet rpsl.ASSet
+		err := serialize.Decode(b, &asSet)
+		require.NoError(t, err)
+		exp := serialize.Attributes{
+			{Key: "remarks-b", Value: "one", After: &serialize.Anchor{Key: "as-set"}},
+			{Key: "remarks-a", Value: "two", After: &serialize.Anchor{Key: "members"}},
+			{Key: "remarks-b", Value: "three", After: &serialize.Anchor{Key: "remarks-a"}},
+		}
+		assert.Equal(t, exp, asSet.Extra)
+		assert.Equal(t, []string{"one", "three"}, asSet.Extra.GetAll("remarks-b"))
+	})
+	t.Run("no known attributes in extra", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route: 192.0.2.0/24
+origin: AS65000
+mnt-by: MNT-ACME
+source: ARIN`)
+		var r rpsl.Route
+		err := serialize.Decode(b, &r)
+		require.NoError(t, err)
+		assert.Nil(t, r.Extra)
+		result, err := serialize.Encode(&r)
+		require.NoError(t, err)
+		assert.Equal(t, b, result)
+	})
+	t.Run("continuation lines", func(t *testing.T) {
+		t.Parallel()
+		b := []byte("aut-num: AS65000\n" +
+			"as-name: AS-ACME-1\n" +
+			"descr: Line 1\n" +
+			"        Line 2\n" +
+			"+\n" +
+			"\tLine 3\n" +
+			"import: from AS65001\n" +
+			"+       accept ANY\n" +
+			"member-of: AS65001,\n" +
+			"  AS-ACME\n")
+		var autNum rpsl.AutNum
+		err := serialize.Decode(b, &autNum)
+		require.NoError(t, err)
+		assert.Equal(t, "Line 1\nLine 2\nLine 3", autNum.Description)
+		assert.Equal(t, "from AS65001 accept ANY", autNum.Import)
+		assert.Equal(t, []string{"AS65001", "AS-ACME"}, autNum.MemberOf)
+	})
+	t.Run("continuation lines comma", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route-set: RS-ACME
+members: 192.0.2.0/24,
+         198.51.100.0/24,
++        RS-CORP`)
+		var rs rpsl.RouteSet
+		err := serialize.Decode(b, &rs)
+		require.NoError(t, err)
+		exp := []string{"192.0.2.0/24", "198.51.100.0/24", "RS-CORP"}
+		assert.Equal(t, exp, rs.Members)
+	})
+	t.Run("continuation lines extra", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route-set: RS-ACME
+remarks-extra: first
+  second`)
+		var rs rpsl.RouteSet
+		err := serialize.Decode(b, &rs)
+		require.NoError(t, err)
+		assert.Equal(t, "first second", rs.Extra.Get("remarks-extra"))
+	})
+	t.Run("skip comments", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`% This is the RIPE Database query service.
+# a comment
+  orphaned continuation
+aut-num: AS65000
+as-name: AS-ACME-1`)
+		var autNum rpsl.AutNum
+		err := serialize.Decode(b, &autNum)
+		require.NoError(t, err)
+		assert.Equal(t, rpsl.ASN(65000), autNum.AutNum)
+		assert.Equal(t, "AS-ACME-1", autNum.ASName)
+		assert.Empty(t, autNum.Extra)
+	})
+	t.Run("crlf line endings", func(t *testing.T) {
+		t.Parallel()
+		b := []byte("aut-num: AS65000\r\nas-name: AS-ACME-1\r\n  continued\r\n")
+		var autNum rpsl.AutNum
+		err := serialize.Decode(b, &autNum)
+		require.NoError(t, err)
+		assert.Equal(t, "AS-ACME-1 continued", autNum.ASName)
+	})
+	t.Run("values with colons", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route6: 2001:db8::/32
+origin: AS65000
+last-modified: 2024-01-02T03:04:05Z`)
+		var r rpsl.Route6
+		err := serialize.Decode(b, &r)
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8::/32", r.Route6.String())
+		assert.Equal(t, "2024-01-02T03:04:05Z", r.Extra.Get("last-modified"))
+	})
+	t.Run("with as comma colons", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route-set: AS65000:RS-ACME
+mp-members: 192.0.2.0/24,2001:db8::/32,AS65000:RS-CORP`)
+		var rs rpsl.RouteSet
+		err := serialize.Decode(b, &rs)
+		require.NoError(t, err)
+		assert.Equal(t, "AS65000:RS-ACME", rs.RouteSet)
+		exp := []string{"192.0.2.0/24", "2001:db8::/32", "AS65000:RS-CORP"}
+		assert.Equal(t, exp, rs.MPMembers)
+	})
+	t.Run("syntax error", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`
+route: 192.0.2.0/24
+origin:   ASX
+mnt-by: MNT-ACME`)
+		var r rpsl.Route
+		err := serialize.Decode(b, &r)
+		var synErr *serialize.SyntaxError
+		require.ErrorAs(t, err, &synErr)
+		assert.Equal(t, 0, synErr.Object)
+		assert.Equal(t, 3, synErr.Line)
+		assert.Equal(t, 11, synErr.Column)
+		assert.Equal(t, "origin", synErr.Attribute)
+		assert.Equal(t, "ASX", synErr.Value)
+		assert.ErrorContains(t, synErr.Err, "could not be parsed")
+	})
+	t.Run("syntax error stops at first", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1 uint32 `rpsl:"key1"`
+			Key2 uint32 `rpsl:"key2"`
+		}
+		b := []byte(`key2: wrong
+key1: wrong`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		var synErr *serialize.SyntaxError
+		require.ErrorAs(t, err, &synErr)
+		assert.Equal(t, "key1", synErr.Attribute)
+		assert.NotContains(t, err.Error(), "key2")
+	})
+	t.Run("all errors", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1 uint32               `rpsl:"key1"`
+			Key2 rpsl.ASN             `rpsl:"key2"`
+			Key3 string               `rpsl:"key3"`
+			Key4 rpsl.ASN             `rpsl:"key4"`
+			Key5 serialize.Attributes `rpsl:"-"`
+		}
+		b := []byte(`key3: value
+key4: AS65000
+key2: wrong
+key1: wrong`)
+		var s Struct
+		err := serialize.DecodeWithOptions(b, &s, serialize.Options{AllErrors: true, Object: 2, Line: 10})
+		require.Error(t, err)
+		multi, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+		errs := multi.Unwrap()
+		require.Len(t, errs, 2)
+		exp := []struct {
+			attr string
+			line int
+		}{{"key2", 13}, {"key1", 14}}
+		for i, e := range exp {
+			var synErr *serialize.SyntaxError
+			require.ErrorAs(t, errs[i], &synErr)
+			assert.Equal(t, e.attr, synErr.Attribute)
+			assert.Equal(t, e.line, synErr.Line)
+			assert.Equal(t, 2, synErr.Object)
+		}
+		// Valid attributes are still decoded.
+		assert.Equal(t, "value", s.Key3)
+		assert.Equal(t, rpsl.ASN(65000), s.Key4)
+	})
+	t.Run("strict", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route: 192.0.2.0/24
+origin: AS65000
+descr: one
+descr: two
+mnt-by: MNT-ACME`)
+		var r rpsl.Route
+		err := serialize.DecodeWithOptions(b, &r, serialize.Options{Strict: true})
+		require.NoError(t, err)
+		assert.Equal(t, "one\ntwo", r.Description)
+	})
+	t.Run("strict missing", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`origin: AS65000`)
+		var r rpsl.Route
+		err := serialize.DecodeWithOptions(b, &r, serialize.Options{Strict: true})
+		assert.ErrorIs(t, err, serialize.ErrMissingAttribute)
+		assert.EqualError(t, err, "rpsl: line 1, column 1: route: mandatory attribute missing")
+	})
+	t.Run("strict duplicate", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route: 192.0.2.0/24
+origin: AS65000
+origin: AS65001`)
+		var r rpsl.Route
+		err := serialize.DecodeWithOptions(b, &r, serialize.Options{Strict: true})
+		assert.ErrorIs(t, err, serialize.ErrDuplicateAttribute)
+		var synErr *serialize.SyntaxError
+		require.ErrorAs(t, err, &synErr)
+		assert.Equal(t, 3, synErr.Line)
+		assert.Equal(t, "AS65001", synErr.Value)
+	})
+	t.Run("strict unknown", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`route: 192.0.2.0/24
+origin: AS65000
+unknown: value`)
+		var r rpsl.Route
+		err := serialize.DecodeWithOptions(b, &r, serialize.Options{Strict: true})
+		assert.ErrorIs(t, err, serialize.ErrUnknownAttribute)
+		assert.Nil(t, r.Extra)
+	})
+	t.Run("strict all errors", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`origin: AS65000
+origin: AS65001
+unknown: value`)
+		var r rpsl.Route
+		err := serialize.DecodeWithOptions(b, &r, serialize.Options{Strict: true, AllErrors: true})
+		assert.ErrorIs(t, err, serialize.ErrMissingAttribute)
+		assert.ErrorIs(t, err, serialize.ErrDuplicateAttribute)
+		assert.ErrorIs(t, err, serialize.ErrUnknownAttribute)
+	})
+	t.Run("strict schema-less", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Extra serialize.Attributes `rpsl:"-"`
+		}
+		b := []byte(`key1: value1
+key1: value2`)
+		var s Struct
+		err := serialize.DecodeWithOptions(b, &s, serialize.Options{Strict: true})
+		require.NoError(t, err)
+		assert.Len(t, s.Extra, 2)
 	})
 	t.Run("err non ptr", func(t *testing.T) {
 		t.Parallel()
@@ -169,6 +464,16 @@ uint32: wrong`)
 		err := serialize.Decode(b, &s)
 		assert.ErrorContains(t, err, "value could not be parsed as uint32")
 	})
+	t.Run("uint32 out of range", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Uint32 uint32 `rpsl:"uint32"`
+		}
+		b := []byte(`uint32: 4294967296`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		assert.ErrorContains(t, err, "value could not be parsed as uint32")
+	})
 	t.Run("with as comma non-slice", func(t *testing.T) {
 		t.Parallel()
 		type Struct struct {
@@ -197,4 +502,52 @@ string2:  value2, value3, value4`)
 		assert.Equal(t, "value", s.String)
 		assert.Equal(t, "value2, value3, value4", s.String2)
 	})
+	t.Run("with as multiline custom slice", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			ASNs []rpsl.ASN `rpsl:"asn" as:"multiline"`
+		}
+		b := []byte(`asn: AS65000
+asn: 65001`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		require.NoError(t, err)
+		assert.Equal(t, []rpsl.ASN{65000, 65001}, s.ASNs)
+	})
+	t.Run("with as comma custom slice", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			ASNs []rpsl.ASN `rpsl:"asn" as:"comma"`
+		}
+		b := []byte(`asn: AS65000, AS65001,
+asn: AS65002`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		require.NoError(t, err)
+		assert.Equal(t, []rpsl.ASN{65000, 65001, 65002}, s.ASNs)
+	})
+	t.Run("err custom slice", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			ASNs []rpsl.ASN `rpsl:"asn" as:"comma"`
+		}
+		b := []byte(`asn: AS65000, ASX`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		assert.ErrorIs(t, err, rpsl.ErrInvalidASN)
+		var synErr *serialize.SyntaxError
+		require.ErrorAs(t, err, &synErr)
+		assert.Equal(t, "asn", synErr.Attribute)
+	})
+	t.Run("skip slice without unmarshal", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Ints []int `rpsl:"int" as:"multiline"`
+		}
+		b := []byte(`int: 1`)
+		var s Struct
+		err := serialize.Decode(b, &s)
+		require.NoError(t, err)
+		assert.Empty(t, s.Ints)
+	})
 }
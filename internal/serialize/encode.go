@@ -1,8 +1,10 @@
 package serialize
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"slices"
 	"strconv"
@@ -11,38 +13,125 @@ import (
 
 var ErrMustBeStruct = errors.New("value must be a struct")
 
-func ProcessAsString(key, val string) string {
-	return fmt.Sprintf("%s: %s\n", key, strings.TrimSpace(strings.Trim(val, "\n")))
+// attrWriter collects RPSL attributes in the order in which they are written, so that attributes
+// decoded from an object can be returned to their place after the attribute that preceded them
+// before the object is written to an io.Writer.
+type attrWriter struct {
+	attrs Attributes
+	// Attributes of the 'Extra' field that were decoded, and are placed by their anchor.
+	placed Attributes
+	// Attributes of the 'Extra' field that were not decoded, and are placed at the field.
+	added Attributes
+	// Index of the 'Extra' field within attrs.
+	mark int
 }
 
-func ProcessAsStringSlice(key string, val []string, sep string) string {
+func (aw *attrWriter) write(key, val string) {
+	aw.attrs = append(aw.attrs, Attribute{Key: key, Value: val})
+}
+
+// flush writes the collected attributes to w, one per line. Decoded 'Extra' attributes are placed,
+// in order, after their anchor, before other 'Extra' attributes are placed at the field. Lines are
+// separated rather than terminated by '\n', so that the output never contains a trailing newline.
+func (aw *attrWriter) flush(w io.Writer) error {
+	attrs, mark := aw.attrs, aw.mark
+	for _, attr := range aw.placed {
+		i := anchorIndex(attrs, *attr.After, mark)
+		if i <= mark {
+			mark++
+		}
+		attrs = slices.Insert(attrs, i, attr)
+	}
+	attrs = slices.Insert(attrs, mark, aw.added...)
+	for i, attr := range attrs {
+		line := attr.Key + ": " + attr.Value
+		if attr.Value == "" {
+			line = attr.Key + ":"
+		}
+		if i > 0 {
+			line = "\n" + line
+		}
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// anchorIndex returns the index after the attribute identified by an anchor, and after any decoded
+// attributes already placed there. If the object has fewer attributes with the anchor's key, the
+// last is used; if it has none, the attribute is placed at mark.
+func anchorIndex(attrs Attributes, anchor Anchor, mark int) int {
+	i, seen := 0, 0
+	if anchor.Key != "" {
+		i = mark
+		for j, attr := range attrs {
+			if strings.EqualFold(attr.Key, anchor.Key) {
+				i, seen = j+1, seen+1
+				if seen == anchor.N {
+					break
+				}
+			}
+		}
+		if seen == 0 {
+			return mark
+		}
+	}
+	for i < len(attrs) && attrs[i].After != nil {
+		i++
+	}
+	return i
+}
+
+func processAsString(aw *attrWriter, key, val string) {
+	aw.write(key, strings.TrimSpace(strings.Trim(val, "\n")))
+}
+
+func processAsStringSlice(aw *attrWriter, key string, val []string, sep string) {
 	vs := strings.TrimSpace(strings.ReplaceAll(strings.Join(val, sep), "\n", " "))
-	return fmt.Sprintf("%s: %s\n", key, vs)
+	aw.write(key, vs)
 }
 
-func ProcessAsMultilineString(key, val string) string {
-	out := ""
+func processAsMultilineString(aw *attrWriter, key, val string) {
 	for _, p := range strings.Split(val, "\n") {
 		if p != "" {
-			out += ProcessAsString(key, p)
+			processAsString(aw, key, p)
 		}
 	}
-	return out
 }
 
-func ProcessAsMultilineStringSlice(key string, val []string) string {
-	out := ""
+// processAsBlockString writes a multiline value line by line, keeping any blank lines within it.
+func processAsBlockString(aw *attrWriter, key, val string) {
+	for _, p := range strings.Split(strings.Trim(val, "\n"), "\n") {
+		if p = strings.TrimSpace(p); p == "" {
+			aw.write(key, "")
+			continue
+		}
+		processAsString(aw, key, p)
+	}
+}
+
+func processAsMultilineStringSlice(aw *attrWriter, key string, val []string) {
 	for _, p := range val {
 		if p != "" {
-			out += ProcessAsString(key, p)
+			processAsString(aw, key, p)
 		}
 	}
-	return out
 }
 
 // Encode encodes an RPSL object to a byte string. The argument must be a pointer to an RPSL
 // object.
 func Encode(s any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := EncodeTo(&buf, s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeTo encodes an RPSL object and writes it to w. The argument must be a pointer to an RPSL
+// object. No trailing newline is written.
+func EncodeTo(w io.Writer, s any) error {
 	t := reflect.TypeOf(s)
 	v := reflect.ValueOf(s)
 	if t.Kind() == reflect.Pointer {
@@ -50,21 +139,26 @@ func Encode(s any) ([]byte, error) {
 		v = v.Elem()
 	}
 	if t.Kind() != reflect.Struct {
-		return nil, ErrMustBeStruct
+		return ErrMustBeStruct
 	}
-	out := ""
-	for i := range t.NumField() {
-		field := t.Field(i)
-		valueField := v.Field(i)
+	aw := &attrWriter{}
+	// Fields of embedded structs are encoded in place, as if they were fields of the struct itself.
+	for _, field := range reflect.VisibleFields(t) {
+		valueField := v.FieldByIndex(field.Index)
 		sval := valueField.Interface()
 		tag := field.Tag.Get("rpsl")
 		if tag == "" {
 			continue
 		}
 		if tag == "-" {
-			for k, v := range sval.(map[string]string) {
-				if k != "" && v != "" {
-					out += fmt.Sprintf("%s: %s\n", k, v)
+			aw.mark = len(aw.attrs)
+			for _, attr := range sval.(Attributes) {
+				switch {
+				case attr.Key == "" || attr.Value == "":
+				case attr.After != nil:
+					aw.placed = append(aw.placed, attr)
+				default:
+					aw.added = append(aw.added, Attribute{Key: attr.Key, Value: attr.Value})
 				}
 			}
 			continue
@@ -76,26 +170,46 @@ func Encode(s any) ([]byte, error) {
 		}
 		key := tags[0]
 		if key == "" {
-			return nil, fmt.Errorf("field '%s' has an invalid rpsl tag", field.Name)
+			return fmt.Errorf("field '%s' has an invalid rpsl tag", field.Name)
 		}
 		as, hasAs := field.Tag.Lookup("as")
 		if hasAs {
 			switch stype := sval.(type) {
 			case string:
 				switch as {
-				case "multiline":
-					out += ProcessAsMultilineString(key, stype)
+				case "multiline", "repeated":
+					processAsMultilineString(aw, key, stype)
+				case "block":
+					processAsBlockString(aw, key, stype)
 				default:
-					out += ProcessAsString(key, stype)
+					processAsString(aw, key, stype)
 				}
 			case []string:
 				switch as {
-				case "multiline":
-					out += ProcessAsMultilineStringSlice(key, stype)
+				case "multiline", "repeated":
+					processAsMultilineStringSlice(aw, key, stype)
+				case "comma-space":
+					processAsStringSlice(aw, key, stype, ", ")
+				case "comma":
+					processAsStringSlice(aw, key, stype, ",")
+				}
+			default:
+				// Slices of other types are encoded element by element, using each element's
+				// string representation.
+				if valueField.Kind() != reflect.Slice {
+					continue
+				}
+				vals := make([]string, 0, valueField.Len())
+				for j := range valueField.Len() {
+					vals = append(vals, fmt.Sprint(valueField.Index(j).Interface()))
+				}
+				switch as {
+				case "multiline", "repeated":
+					processAsMultilineStringSlice(aw, key, vals)
 				case "comma-space":
-					out += ProcessAsStringSlice(key, stype, ", ")
+					processAsStringSlice(aw, key, vals, ", ")
 				case "comma":
-					out += ProcessAsStringSlice(key, stype, ",")
+					processAsStringSlice(aw, key, vals, ",")
 				}
 			}
 		} else {
@@ -106,9 +220,8 @@ func Encode(s any) ([]byte, error) {
 			default:
 				value = fmt.Sprint(stype)
 			}
-			out += fmt.Sprintf("%s: %s\n", key, value)
+			aw.write(key, value)
 		}
 	}
-	out = strings.TrimSuffix(out, "\n")
-	return []byte(out), nil
+	return aw.flush(w)
 }
@@ -1,10 +1,12 @@
 package serialize_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
 	"go.mdl.wtf/rpsl/internal/serialize"
 )
 
@@ -42,6 +44,36 @@ key2: 65000`)
 key2: 65000`)
 		assert.Equal(t, exp, result)
 	})
+	t.Run("embedded", func(t *testing.T) {
+		t.Parallel()
+		type Embedded struct {
+			Key2 string `rpsl:"key2,omitempty"`
+			Key3 string `rpsl:"key3,omitempty"`
+		}
+		type Struct struct {
+			Key1 string `rpsl:"key1"`
+			Embedded
+			Key4 string `rpsl:"key4"`
+		}
+		s := &Struct{Key1: "value1", Embedded: Embedded{Key3: "value3"}, Key4: "value4"}
+		result, err := serialize.Encode(s)
+		require.NoError(t, err)
+		exp := []byte(`key1: value1
+key3: value3
+key4: value4`)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("encode to", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1 string `rpsl:"key1"`
+			Key2 uint32 `rpsl:"key2"`
+		}
+		var buf bytes.Buffer
+		err := serialize.EncodeTo(&buf, &Struct{Key1: "value1", Key2: 65000})
+		require.NoError(t, err)
+		assert.Equal(t, "key1: value1\nkey2: 65000", buf.String())
+	})
 	t.Run("non-struct", func(t *testing.T) {
 		t.Parallel()
 		_, err := serialize.Encode("non-struct")
@@ -81,18 +113,49 @@ key2: 65000`)
 	})
 	t.Run("with extra", func(t *testing.T) {
 		type Struct struct {
-			Key1  string            `rpsl:"key1"`
-			Key2  uint32            `rpsl:"key2"`
-			Extra map[string]string `rpsl:"-"`
+			Key1  string               `rpsl:"key1"`
+			Key2  uint32               `rpsl:"key2"`
+			Extra serialize.Attributes `rpsl:"-"`
 		}
 		s := &Struct{
-			Key1:  "value1",
-			Key2:  65000,
-			Extra: map[string]string{"key3": "value3"},
+			Key1: "value1",
+			Key2: 65000,
+			Extra: serialize.Attributes{
+				{Key: "key3", Value: "value3"},
+				{Key: "key4", Value: "value4"},
+				{Key: "key3", Value: "value3-2"},
+			},
 		}
 		exp := []byte(`key1: value1
 key2: 65000
-key3: value3`)
+key3: value3
+key4: value4
+key3: value3-2`)
+		result, err := serialize.Encode(s)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("with decoded extra", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1  string               `rpsl:"key1"`
+			Extra serialize.Attributes `rpsl:"-"`
+			Key2  string               `rpsl:"key2"`
+		}
+		s := &Struct{
+			Key1: "value1",
+			Key2: "value2",
+			Extra: serialize.Attributes{
+				{Key: "key3", Value: "value3"},
+				{Key: "key4", Value: "value4", After: &serialize.Anchor{}},
+				{Key: "key5", Value: "value5", After: &serialize.Anchor{Key: "key2"}},
+			},
+		}
+		exp := []byte(`key4: value4
+key1: value1
+key3: value3
+key2: value2
+key5: value5`)
 		result, err := serialize.Encode(s)
 		require.NoError(t, err)
 		assert.Equal(t, exp, result)
@@ -115,6 +178,19 @@ key2: value2-1
 key2: value2-2`)
 		assert.Equal(t, exp, result)
 	})
+	t.Run("with as block string", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			Key1 string `rpsl:"key1" as:"block"`
+		}
+		s := &Struct{Key1: "value1-1\n\nvalue1-2\n"}
+		result, err := serialize.Encode(s)
+		require.NoError(t, err)
+		exp := []byte(`key1: value1-1
+key1:
+key1: value1-2`)
+		assert.Equal(t, exp, result)
+	})
 	t.Run("with as multiline string slice", func(t *testing.T) {
 		t.Parallel()
 		type Struct struct {
@@ -180,4 +256,22 @@ key2: value2-1,value2-2`)
 key2: value2-1,value2-2`)
 		assert.Equal(t, exp, result)
 	})
+	t.Run("with as multiline custom slice", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			ASNs []rpsl.ASN `rpsl:"asn" as:"multiline"`
+		}
+		result, err := serialize.Encode(&Struct{ASNs: []rpsl.ASN{65000, 65001}})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("asn: AS65000\nasn: AS65001"), result)
+	})
+	t.Run("with as comma-space custom slice", func(t *testing.T) {
+		t.Parallel()
+		type Struct struct {
+			ASNs []rpsl.ASN `rpsl:"asn" as:"comma-space"`
+		}
+		result, err := serialize.Encode(&Struct{ASNs: []rpsl.ASN{65000, 65001}})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("asn: AS65000, AS65001"), result)
+	})
 }
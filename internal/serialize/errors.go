@@ -1,10 +1,21 @@
 package serialize
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 )
 
+// ErrMissingAttribute describes a mandatory attribute that is missing from an object.
+var ErrMissingAttribute = errors.New("mandatory attribute missing")
+
+// ErrDuplicateAttribute describes a single-valued attribute that appears more than once in an
+// object.
+var ErrDuplicateAttribute = errors.New("single-valued attribute repeated")
+
+// ErrUnknownAttribute describes an attribute that is not defined for an object's class.
+var ErrUnknownAttribute = errors.New("unknown attribute")
+
 // UnmarshalBinaryErr describes an invalid argument passed to rpsl.UnmarshalBinary.
 // The argument to rpsl.UnmarshalBinary must be a non-nil pointer.
 type UnmarshalBinaryErr struct {
@@ -21,3 +32,32 @@ func (e *UnmarshalBinaryErr) Error() string {
 	}
 	return fmt.Sprintf("rpsl: UnmarshalBinary(%s %s)", e.Type.Kind(), e.Type.String())
 }
+
+// SyntaxError describes an RPSL attribute whose value could not be decoded.
+type SyntaxError struct {
+	// Zero-based index of the object within a stream. Always 0 when decoding a single object.
+	Object int
+	// 1-based line number of the attribute.
+	Line int
+	// 1-based column at which the attribute's value begins.
+	Column int
+	// Attribute name, e.g. origin.
+	Attribute string
+	// Raw attribute value.
+	Value string
+	// Underlying cause.
+	Err error
+}
+
+// Error returns a string representation of the error.
+func (e *SyntaxError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("rpsl: line %d, column %d: %s: %v", e.Line, e.Column, e.Attribute, e.Err)
+	}
+	return fmt.Sprintf("rpsl: line %d, column %d: %s: invalid value '%s': %v", e.Line, e.Column, e.Attribute, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying cause of the error.
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
@@ -1,6 +1,7 @@
 package serialize_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -35,3 +36,23 @@ func Test_UnmarshalBinaryErr(t *testing.T) {
 		assert.ErrorContains(t, err, "rpsl: UnmarshalBinary(ptr *serialize_test.TestStruct)")
 	})
 }
+
+func Test_SyntaxError(t *testing.T) {
+	cause := errors.New("cause")
+	err := &serialize.SyntaxError{
+		Object:    1,
+		Line:      3,
+		Column:    9,
+		Attribute: "origin",
+		Value:     "ASX",
+		Err:       cause,
+	}
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		assert.EqualError(t, err, "rpsl: line 3, column 9: origin: invalid value 'ASX': cause")
+	})
+	t.Run("unwrap", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorIs(t, err, cause)
+	})
+}
@@ -0,0 +1,51 @@
+package serialize
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Spec describes an RPSL attribute, as declared by the tags of the struct field it is mapped to.
+//
+// An attribute is mandatory if its rpsl tag includes 'mandatory', and may appear more than once in
+// an object if its rpsl tag includes 'multiple', e.g. `rpsl:"descr,omitempty,multiple"`. Both are
+// independent of 'omitempty', which only affects encoding.
+type Spec struct {
+	// Attribute name, e.g. origin.
+	Key string
+	// Name of the struct field the attribute is mapped to.
+	Field string
+	// Whether the attribute must be present.
+	Mandatory bool
+	// Whether the attribute may appear more than once.
+	Multiple bool
+}
+
+// specCache caches attribute specs by struct type.
+var specCache sync.Map
+
+// Specs returns the attribute specs of a struct type, in field order. Fields of embedded structs are
+// included in place of the embedded struct. Fields without an rpsl tag and the 'Extra' field
+// (tagged as "-") are omitted.
+func Specs(rt reflect.Type) []Spec {
+	if cached, ok := specCache.Load(rt); ok {
+		return cached.([]Spec)
+	}
+	specs := make([]Spec, 0, rt.NumField())
+	for _, field := range reflect.VisibleFields(rt) {
+		tags := strings.Split(field.Tag.Get("rpsl"), ",")
+		if tags[0] == "" || tags[0] == "-" {
+			continue
+		}
+		specs = append(specs, Spec{
+			Key:       tags[0],
+			Field:     field.Name,
+			Mandatory: slices.Contains(tags[1:], "mandatory"),
+			Multiple:  slices.Contains(tags[1:], "multiple"),
+		})
+	}
+	specCache.Store(rt, specs)
+	return specs
+}
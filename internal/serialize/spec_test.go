@@ -0,0 +1,51 @@
+package serialize_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+func Test_Specs(t *testing.T) {
+	t.Parallel()
+	type Struct struct {
+		Key1  string   `rpsl:"key1,mandatory"`
+		Key2  string   `rpsl:"key2,omitempty"`
+		Key3  []string `rpsl:"key3,omitempty,multiple" as:"comma"`
+		Key4  string   `rpsl:"key4,mandatory,multiple" as:"multiline"`
+		Key5  string   `rpsl:"key5"`
+		Skip  string
+		Extra serialize.Attributes `rpsl:"-"`
+	}
+	exp := []serialize.Spec{
+		{Key: "key1", Field: "Key1", Mandatory: true, Multiple: false},
+		{Key: "key2", Field: "Key2", Mandatory: false, Multiple: false},
+		{Key: "key3", Field: "Key3", Mandatory: false, Multiple: true},
+		{Key: "key4", Field: "Key4", Mandatory: true, Multiple: true},
+		{Key: "key5", Field: "Key5", Mandatory: false, Multiple: false},
+	}
+	rt := reflect.TypeFor[Struct]()
+	assert.Equal(t, exp, serialize.Specs(rt))
+	// Cached result.
+	assert.Equal(t, exp, serialize.Specs(rt))
+}
+
+func Test_Specs_Embedded(t *testing.T) {
+	t.Parallel()
+	type Embedded struct {
+		Key2 string `rpsl:"key2,omitempty,multiple"`
+	}
+	type Struct struct {
+		Key1 string `rpsl:"key1,mandatory"`
+		Embedded
+		Key3 string `rpsl:"key3"`
+	}
+	exp := []serialize.Spec{
+		{Key: "key1", Field: "Key1", Mandatory: true},
+		{Key: "key2", Field: "Key2", Multiple: true},
+		{Key: "key3", Field: "Key3"},
+	}
+	assert.Equal(t, exp, serialize.Specs(reflect.TypeFor[Struct]()))
+}
@@ -0,0 +1,187 @@
+package rpsl
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// IPv4Range is an inclusive range of IPv4 addresses, as used by inetnum objects, e.g.
+// 192.0.2.0 - 192.0.2.255. Unlike a prefix, a range need not be aligned to a power of two. The zero
+// value is an invalid range, which represents a missing value.
+type IPv4Range struct {
+	// First address in the range.
+	First netip.Addr
+	// Last address in the range.
+	Last netip.Addr
+}
+
+// ParseIPv4Range parses an IPv4 range in RPSL format, e.g. 192.0.2.0 - 192.0.2.255. A prefix, e.g.
+// 192.0.2.0/24, is also accepted and converted to the range of addresses it contains. The first
+// address must not be greater than the last.
+func ParseIPv4Range(s string) (IPv4Range, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		p, err := parsePrefix(s, 4)
+		if err != nil {
+			return IPv4Range{}, err
+		}
+		return IPv4RangeFromPrefix(p)
+	}
+	first, last, found := strings.Cut(s, "-")
+	if !found {
+		return IPv4Range{}, fmt.Errorf("%w: value '%s' is not in the format 'a.b.c.d - e.f.g.h'", ErrInvalidRange, s)
+	}
+	f, err := netip.ParseAddr(strings.TrimSpace(first))
+	if err != nil {
+		return IPv4Range{}, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+	l, err := netip.ParseAddr(strings.TrimSpace(last))
+	if err != nil {
+		return IPv4Range{}, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+	r := IPv4Range{First: f, Last: l}
+	if err := r.check(); err != nil {
+		return IPv4Range{}, err
+	}
+	return r, nil
+}
+
+// MustParseIPv4Range calls rpsl.ParseIPv4Range(s) and panics on error. It is intended for use in
+// tests with hard-coded strings.
+func MustParseIPv4Range(s string) IPv4Range {
+	r, err := ParseIPv4Range(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IPv4RangeFromPrefix creates a range of the addresses contained by an IPv4 prefix, which must not
+// have host bits set.
+func IPv4RangeFromPrefix(p netip.Prefix) (IPv4Range, error) {
+	if err := checkPrefix(p, 4); err != nil {
+		return IPv4Range{}, fmt.Errorf("%w: prefix '%s': %w", ErrInvalidRange, p, err)
+	}
+	first := ipv4ToUint32(p.Addr())
+	last := first | uint32(uint64(1)<<(32-p.Bits())-1)
+	return IPv4Range{First: p.Addr(), Last: uint32ToIPv4(last)}, nil
+}
+
+// IPv4RangeFromPrefixes creates a range of the addresses contained by a list of IPv4 prefixes,
+// which must be contiguous once sorted, e.g. 192.0.2.0/25 and 192.0.2.128/25.
+func IPv4RangeFromPrefixes(prefixes ...netip.Prefix) (IPv4Range, error) {
+	if len(prefixes) == 0 {
+		return IPv4Range{}, fmt.Errorf("%w: no prefixes", ErrInvalidRange)
+	}
+	ranges := make([]IPv4Range, 0, len(prefixes))
+	for _, p := range prefixes {
+		r, err := IPv4RangeFromPrefix(p)
+		if err != nil {
+			return IPv4Range{}, err
+		}
+		ranges = append(ranges, r)
+	}
+	slices.SortFunc(ranges, func(a, b IPv4Range) int {
+		return cmp.Compare(ipv4ToUint32(a.First), ipv4ToUint32(b.First))
+	})
+	out := ranges[0]
+	for _, r := range ranges[1:] {
+		if ipv4ToUint32(out.Last) == 0xffffffff || ipv4ToUint32(r.First) != ipv4ToUint32(out.Last)+1 {
+			return IPv4Range{}, fmt.Errorf("%w: prefix '%s' is not contiguous with range '%s'", ErrInvalidRange, r.Prefixes()[0], out)
+		}
+		out.Last = r.Last
+	}
+	return out, nil
+}
+
+// check checks that the range's addresses are IPv4 addresses, and that the first address is not
+// greater than the last.
+func (r IPv4Range) check() error {
+	if !r.First.Is4() || !r.Last.Is4() {
+		return fmt.Errorf("%w: %w", ErrInvalidRange, ErrWrongFamily)
+	}
+	if r.First.Compare(r.Last) > 0 {
+		return fmt.Errorf("%w: first address %s is greater than last address %s", ErrInvalidRange, r.First, r.Last)
+	}
+	return nil
+}
+
+// IsValid determines if the range's addresses are IPv4 addresses, and the first address is not
+// greater than the last.
+func (r IPv4Range) IsValid() bool {
+	return r.check() == nil
+}
+
+// String represents the range in RPSL format, e.g. 192.0.2.0 - 192.0.2.255. An invalid range is
+// represented as an empty string.
+func (r IPv4Range) String() string {
+	if !r.IsValid() {
+		return ""
+	}
+	return r.First.String() + " - " + r.Last.String()
+}
+
+// MarshalBinary encodes the range in RPSL format.
+func (r IPv4Range) MarshalBinary() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an IPv4Range type.
+func (r IPv4Range) UnmarshalBinary(b []byte) (IPv4Range, error) {
+	return ParseIPv4Range(string(b))
+}
+
+// Contains determines if an address is within the range.
+func (r IPv4Range) Contains(a netip.Addr) bool {
+	return r.IsValid() && a.Is4() && a.Compare(r.First) >= 0 && a.Compare(r.Last) <= 0
+}
+
+// ContainsPrefix determines if every address of a prefix is within the range.
+func (r IPv4Range) ContainsPrefix(p netip.Prefix) bool {
+	pr, err := IPv4RangeFromPrefix(p.Masked())
+	if err != nil {
+		return false
+	}
+	return r.Contains(pr.First) && r.Contains(pr.Last)
+}
+
+// Size returns the number of addresses in the range. An invalid range has a size of zero.
+func (r IPv4Range) Size() uint64 {
+	if !r.IsValid() {
+		return 0
+	}
+	return uint64(ipv4ToUint32(r.Last)-ipv4ToUint32(r.First)) + 1
+}
+
+// Prefixes returns the minimal list of prefixes that exactly cover the range, in ascending order,
+// e.g. 192.0.2.0 - 192.0.2.191 is covered by 192.0.2.0/25 and 192.0.2.128/26.
+func (r IPv4Range) Prefixes() []netip.Prefix {
+	if !r.IsValid() {
+		return nil
+	}
+	start, end := uint64(ipv4ToUint32(r.First)), uint64(ipv4ToUint32(r.Last))
+	var out []netip.Prefix
+	for start <= end {
+		// The largest block aligned at start, reduced until it fits within the range.
+		size := uint64(1) << bits.TrailingZeros32(uint32(start))
+		for size > end-start+1 {
+			size >>= 1
+		}
+		out = append(out, netip.PrefixFrom(uint32ToIPv4(uint32(start)), 33-bits.Len64(size)))
+		start += size
+	}
+	return out
+}
+
+func ipv4ToUint32(a netip.Addr) uint32 {
+	b := a.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+func uint32ToIPv4(u uint32) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(u >> 24), byte(u >> 16), byte(u >> 8), byte(u)})
+}
@@ -0,0 +1,183 @@
+package rpsl_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_ParseIPv4Range(t *testing.T) {
+	cases := []struct {
+		in    string
+		first string
+		last  string
+	}{
+		{"192.0.2.0 - 192.0.2.255", "192.0.2.0", "192.0.2.255"},
+		{"192.0.2.0-192.0.2.255", "192.0.2.0", "192.0.2.255"},
+		{"192.0.2.10 - 192.0.2.10", "192.0.2.10", "192.0.2.10"},
+		{"192.0.2.0 - 198.51.100.7", "192.0.2.0", "198.51.100.7"},
+		{"192.0.2.0/24", "192.0.2.0", "192.0.2.255"},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255"},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			r, err := rpsl.ParseIPv4Range(c.in)
+			require.NoError(t, err)
+			assert.Equal(t, netip.MustParseAddr(c.first), r.First)
+			assert.Equal(t, netip.MustParseAddr(c.last), r.Last)
+			assert.Equal(t, c.first+" - "+c.last, r.String())
+		})
+	}
+	errCases := []struct {
+		in  string
+		err error
+	}{
+		{"192.0.2.0", rpsl.ErrInvalidRange},
+		{"192.0.2.255 - 192.0.2.0", rpsl.ErrInvalidRange},
+		{"192.0.2.0 - 192.0.2.256", rpsl.ErrInvalidRange},
+		{"2001:db8:: - 2001:db8::ff", rpsl.ErrWrongFamily},
+		{"192.0.2.0 - 2001:db8::", rpsl.ErrWrongFamily},
+		{"192.0.2.1/24", rpsl.ErrHostBits},
+		{"2001:db8::/32", rpsl.ErrWrongFamily},
+	}
+	for _, c := range errCases {
+		t.Run("err "+c.in, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseIPv4Range(c.in)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+	t.Run("must panics", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { rpsl.MustParseIPv4Range("192.0.2.255 - 192.0.2.0") })
+	})
+}
+
+func TestIPv4Range_Prefixes(t *testing.T) {
+	cases := []struct {
+		in  string
+		exp []string
+	}{
+		{"192.0.2.0 - 192.0.2.255", []string{"192.0.2.0/24"}},
+		{"192.0.2.0 - 192.0.2.191", []string{"192.0.2.0/25", "192.0.2.128/26"}},
+		{"192.0.2.1 - 192.0.2.6", []string{"192.0.2.1/32", "192.0.2.2/31", "192.0.2.4/31", "192.0.2.6/32"}},
+		{"192.0.2.7 - 192.0.2.7", []string{"192.0.2.7/32"}},
+		{"192.0.2.0 - 192.0.3.255", []string{"192.0.2.0/23"}},
+		{"192.0.1.0 - 192.0.2.255", []string{"192.0.1.0/24", "192.0.2.0/24"}},
+		{"0.0.0.0 - 255.255.255.255", []string{"0.0.0.0/0"}},
+		{"255.255.255.254 - 255.255.255.255", []string{"255.255.255.254/31"}},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			r := rpsl.MustParseIPv4Range(c.in)
+			prefixes := r.Prefixes()
+			got := make([]string, 0, len(prefixes))
+			for _, p := range prefixes {
+				got = append(got, p.String())
+			}
+			assert.Equal(t, c.exp, got)
+			// Converting the prefixes back results in the same range.
+			back, err := rpsl.IPv4RangeFromPrefixes(prefixes...)
+			require.NoError(t, err)
+			assert.Equal(t, r, back)
+		})
+	}
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, rpsl.IPv4Range{}.Prefixes())
+	})
+}
+
+func Test_IPv4RangeFromPrefix(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		r, err := rpsl.IPv4RangeFromPrefix(netip.MustParsePrefix("192.0.2.0/24"))
+		require.NoError(t, err)
+		assert.Equal(t, "192.0.2.0 - 192.0.2.255", r.String())
+	})
+	t.Run("err ipv6", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefix(netip.MustParsePrefix("2001:db8::/32"))
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+	})
+	t.Run("err zero", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefix(netip.Prefix{})
+		assert.ErrorIs(t, err, rpsl.ErrInvalidPrefix)
+	})
+	t.Run("err host bits", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefix(netip.MustParsePrefix("192.0.2.1/24"))
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+}
+
+func Test_IPv4RangeFromPrefixes(t *testing.T) {
+	t.Run("unsorted", func(t *testing.T) {
+		t.Parallel()
+		r, err := rpsl.IPv4RangeFromPrefixes(netip.MustParsePrefix("192.0.2.128/26"), netip.MustParsePrefix("192.0.2.0/25"))
+		require.NoError(t, err)
+		assert.Equal(t, "192.0.2.0 - 192.0.2.191", r.String())
+	})
+	t.Run("err gap", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefixes(netip.MustParsePrefix("192.0.2.0/26"), netip.MustParsePrefix("192.0.2.128/26"))
+		assert.ErrorIs(t, err, rpsl.ErrInvalidRange)
+	})
+	t.Run("err overlap", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefixes(netip.MustParsePrefix("192.0.2.0/24"), netip.MustParsePrefix("192.0.2.0/25"))
+		assert.ErrorIs(t, err, rpsl.ErrInvalidRange)
+	})
+	t.Run("err empty", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefixes()
+		assert.ErrorIs(t, err, rpsl.ErrInvalidRange)
+	})
+	t.Run("err ipv6", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.IPv4RangeFromPrefixes(netip.MustParsePrefix("2001:db8::/32"))
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+	})
+}
+
+func TestIPv4Range(t *testing.T) {
+	r := rpsl.MustParseIPv4Range("192.0.2.0 - 192.0.2.191")
+	t.Run("contains", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, r.Contains(netip.MustParseAddr("192.0.2.0")))
+		assert.True(t, r.Contains(netip.MustParseAddr("192.0.2.191")))
+		assert.False(t, r.Contains(netip.MustParseAddr("192.0.2.192")))
+		assert.False(t, r.Contains(netip.MustParseAddr("::ffff:192.0.2.1")))
+	})
+	t.Run("contains prefix", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, r.ContainsPrefix(netip.MustParsePrefix("192.0.2.128/26")))
+		assert.False(t, r.ContainsPrefix(netip.MustParsePrefix("192.0.2.128/25")))
+		assert.False(t, r.ContainsPrefix(netip.MustParsePrefix("2001:db8::/32")))
+	})
+	t.Run("size", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, uint64(192), r.Size())
+		assert.Equal(t, uint64(1)<<32, rpsl.MustParseIPv4Range("0.0.0.0/0").Size())
+		assert.Zero(t, rpsl.IPv4Range{}.Size())
+	})
+	t.Run("marshal", func(t *testing.T) {
+		t.Parallel()
+		b, err := r.MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("192.0.2.0 - 192.0.2.191"), b)
+		u, err := r.UnmarshalBinary(b)
+		require.NoError(t, err)
+		assert.Equal(t, r, u)
+	})
+	t.Run("invalid string", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, rpsl.IPv4Range{}.String())
+	})
+}
@@ -0,0 +1,337 @@
+package rpsl
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// KeyCertMethodPGP is the method of a key-cert object holding an OpenPGP public key.
+	KeyCertMethodPGP = "PGP"
+	// KeyCertMethodX509 is the method of a key-cert object holding an X.509 certificate.
+	KeyCertMethodX509 = "X509"
+)
+
+const (
+	armorBegin = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	armorEnd   = "-----END PGP PUBLIC KEY BLOCK-----"
+)
+
+// OpenPGP packet tags, per RFC 4880 section 4.3.
+const (
+	packetPublicKey = 6
+	packetUserID    = 13
+)
+
+// Fingerprint is an OpenPGP v4 key fingerprint.
+type Fingerprint [20]byte
+
+// String represents the fingerprint as a key-cert fingerpr value, i.e. as ten groups of four
+// hexadecimal digits, e.g. 175D 0272 C908 A6A1 E8D6  6670 9E51 8888 3E33 8801.
+func (f Fingerprint) String() string {
+	h := strings.ToUpper(hex.EncodeToString(f[:]))
+	var b strings.Builder
+	for i := 0; i < len(h); i += 4 {
+		switch i {
+		case 0:
+		case len(h) / 2:
+			b.WriteString("  ")
+		default:
+			b.WriteString(" ")
+		}
+		b.WriteString(h[i : i+4])
+	}
+	return b.String()
+}
+
+// PGPKey is an OpenPGP public key, as held by the certif attribute of a key-cert object.
+type PGPKey struct {
+	// Fingerprint of the primary key.
+	Fingerprint Fingerprint
+	// User IDs of the key, e.g. ACME NOC <noc@example.com>.
+	UserIDs []string
+}
+
+// ParsePGPKey parses an ASCII-armored OpenPGP v4 public key, e.g. a key-cert certif value. Only the
+// primary key's fingerprint and user IDs are parsed; signatures are not verified.
+func ParsePGPKey(armored string) (PGPKey, error) {
+	b, err := decodeArmor(armored)
+	if err != nil {
+		return PGPKey{}, err
+	}
+	var k PGPKey
+	for i := 0; len(b) > 0; i++ {
+		var tag byte
+		var body []byte
+		if tag, body, b, err = readPacket(b); err != nil {
+			return PGPKey{}, err
+		}
+		switch {
+		case i == 0 && tag != packetPublicKey:
+			return PGPKey{}, fmt.Errorf("%w: key does not begin with a public key packet", ErrInvalidKeyCert)
+		case i == 0:
+			if len(body) == 0 || body[0] != 4 {
+				return PGPKey{}, fmt.Errorf("%w: unsupported public key version", ErrInvalidKeyCert)
+			}
+			// A v4 fingerprint is the SHA-1 hash of 0x99, the two-octet packet length, and the
+			// packet body, per RFC 4880 section 12.2.
+			h := sha1.New()
+			h.Write([]byte{0x99, byte(len(body) >> 8), byte(len(body))})
+			h.Write(body)
+			copy(k.Fingerprint[:], h.Sum(nil))
+		case tag == packetUserID:
+			k.UserIDs = append(k.UserIDs, string(body))
+		}
+	}
+	if len(k.UserIDs) == 0 {
+		return PGPKey{}, fmt.Errorf("%w: key has no user ID", ErrInvalidKeyCert)
+	}
+	return k, nil
+}
+
+// decodeArmor decodes an ASCII-armored public key block, per RFC 4880 section 6.2, verifying its
+// checksum if present.
+func decodeArmor(armored string) ([]byte, error) {
+	var data strings.Builder
+	var checksum string
+	begun, ended, inHeaders := false, false, false
+	for _, line := range strings.Split(armored, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case !begun:
+			begun = line == armorBegin
+			inHeaders = begun
+		case ended:
+		case line == armorEnd:
+			ended = true
+		case line == "":
+			inHeaders = false
+		case inHeaders && strings.Contains(line, ": "):
+			// Armor headers, e.g. Comment: or Version:, carry no key material.
+		case strings.HasPrefix(line, "="):
+			checksum = line[1:]
+		default:
+			inHeaders = false
+			data.WriteString(line)
+		}
+	}
+	if !begun || !ended {
+		return nil, fmt.Errorf("%w: certif is not an ASCII-armored public key block", ErrInvalidKeyCert)
+	}
+	b, err := base64.StdEncoding.DecodeString(data.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeyCert, err)
+	}
+	if checksum != "" {
+		sum, err := base64.StdEncoding.DecodeString(checksum)
+		if err != nil || len(sum) != 3 || uint32(sum[0])<<16|uint32(sum[1])<<8|uint32(sum[2]) != crc24(b) {
+			return nil, fmt.Errorf("%w: armor checksum mismatch", ErrInvalidKeyCert)
+		}
+	}
+	return b, nil
+}
+
+// crc24 computes the armor checksum of RFC 4880 section 6.1.
+func crc24(b []byte) uint32 {
+	crc := uint32(0xb704ce)
+	for _, c := range b {
+		crc ^= uint32(c) << 16
+		for range 8 {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= 0x1864cfb
+			}
+		}
+	}
+	return crc & 0xffffff
+}
+
+// readPacket reads an OpenPGP packet in either the old or new format, per RFC 4880 section 4.2,
+// returning its tag, its body, and the remaining bytes. Partial body lengths are not supported, as
+// they are not permitted in public key packets.
+func readPacket(b []byte) (byte, []byte, []byte, error) {
+	errTruncated := fmt.Errorf("%w: truncated packet", ErrInvalidKeyCert)
+	if len(b) == 0 || b[0]&0x80 == 0 {
+		return 0, nil, nil, fmt.Errorf("%w: malformed packet header", ErrInvalidKeyCert)
+	}
+	var tag byte
+	var length, n int
+	if b[0]&0x40 == 0 {
+		// Old format: the tag and the size of the length field are packed into the first octet.
+		tag = (b[0] >> 2) & 0x0f
+		switch b[0] & 0x03 {
+		case 0:
+			n = 1
+		case 1:
+			n = 2
+		case 2:
+			n = 4
+		case 3:
+			// Indeterminate length: the packet extends to the end of the data.
+			return tag, b[1:], nil, nil
+		}
+		if len(b) < 1+n {
+			return 0, nil, nil, errTruncated
+		}
+		var buf [4]byte
+		copy(buf[4-n:], b[1:1+n])
+		length, n = int(binary.BigEndian.Uint32(buf[:])), n+1
+	} else {
+		// New format: the tag is in the first octet and the length is encoded in one, two, or
+		// five octets.
+		tag = b[0] & 0x3f
+		if len(b) < 2 {
+			return 0, nil, nil, errTruncated
+		}
+		switch first := int(b[1]); {
+		case first < 192:
+			length, n = first, 2
+		case first < 224:
+			if len(b) < 3 {
+				return 0, nil, nil, errTruncated
+			}
+			length, n = (first-192)<<8+int(b[2])+192, 3
+		case first == 255:
+			if len(b) < 6 {
+				return 0, nil, nil, errTruncated
+			}
+			length, n = int(binary.BigEndian.Uint32(b[2:6])), 6
+		default:
+			return 0, nil, nil, fmt.Errorf("%w: partial body lengths are not supported", ErrInvalidKeyCert)
+		}
+	}
+	if length < 0 || len(b)-n < length {
+		return 0, nil, nil, errTruncated
+	}
+	return tag, b[n : n+length], b[n+length:], nil
+}
+
+// KeyID returns the 64-bit key ID of the key, i.e. the last 16 hexadecimal digits of its
+// fingerprint, e.g. 9E5188883E338801.
+func (k PGPKey) KeyID() string {
+	return strings.ToUpper(hex.EncodeToString(k.Fingerprint[12:]))
+}
+
+// KeyCert returns the name of the key-cert object holding the key, i.e. PGPKEY- followed by the
+// last 8 hexadecimal digits of its fingerprint, e.g. PGPKEY-3E338801.
+func (k PGPKey) KeyCert() string {
+	return string(AuthPGPKey) + "-" + k.KeyID()[8:]
+}
+
+// KeyCert is an RPSL 'key-cert class' object. A key-cert object holds a public key used to
+// authenticate updates, referenced by mntner auth values, e.g. PGPKEY-3E338801. See RFC 2726
+// section 2.
+type KeyCert struct {
+	// Name of the key-cert object, e.g. PGPKEY-3E338801.
+	//    *Required
+	KeyCert string `rpsl:"key-cert,mandatory"`
+	// Type of the key, e.g. PGP. Generated from the key by the registry.
+	Method string `rpsl:"method,omitempty"`
+	// User IDs of the key, separated by newlines. Generated from the key by the registry.
+	Owner string `rpsl:"owner,omitempty,multiple" as:"multiline"`
+	// Fingerprint of the key. Generated from the key by the registry.
+	Fingerprint string `rpsl:"fingerpr,omitempty"`
+	// ASCII-armored public key. Blank lines within the key are retained.
+	//    *Required
+	Certif string `rpsl:"certif,mandatory,multiple" as:"block"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Email addresses to be notified of changes to the key-cert object, separated by newlines.
+	Notify string `rpsl:"notify,omitempty,multiple" as:"multiline"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the key-cert object. Multiple maintainers are separated
+	// by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the key-cert object.
+func (k *KeyCert) AddExtra(key, value string) {
+	k.Extra.Add(key, value)
+}
+
+// String representation of the key-cert in RPSL format. E.g. PGPKEY-3E338801.
+func (k *KeyCert) String() string {
+	return k.KeyCert
+}
+
+// PublicKey parses the key-cert's certif attribute as an OpenPGP public key.
+func (k *KeyCert) PublicKey() (PGPKey, error) {
+	return ParsePGPKey(k.Certif)
+}
+
+// Generate sets the key-cert's method, owner, and fingerpr attributes from its key, as a registry
+// does when the object is created. The key-cert name is also set if it is empty.
+func (k *KeyCert) Generate() error {
+	key, err := k.PublicKey()
+	if err != nil {
+		return err
+	}
+	if k.KeyCert == "" {
+		k.KeyCert = key.KeyCert()
+	}
+	k.Method = KeyCertMethodPGP
+	k.Owner = strings.Join(key.UserIDs, "\n")
+	k.Fingerprint = key.Fingerprint.String()
+	return nil
+}
+
+// Validate validates the key-cert object, returning all problems found. The key-cert name must
+// reference its key's fingerprint, and any method, owner, and fingerpr values must match those
+// generated from the key. The certif of X509 key-cert objects is not parsed.
+func (k *KeyCert) Validate() error {
+	errs := validateMandatory(k)
+	if k.KeyCert == "" {
+		return errors.Join(errs...)
+	}
+	name, err := ParseAuth(k.KeyCert)
+	if err != nil || name.KeyCert() == "" || name.Filtered {
+		err := fmt.Errorf("%w: name is not in the format 'PGPKEY-<key ID>' or 'X509-<number>'", ErrInvalidKeyCert)
+		errs = append(errs, &ValidationError{Attribute: "key-cert", Value: k.KeyCert, Err: err})
+		return errors.Join(errs...)
+	}
+	method := KeyCertMethodPGP
+	if name.Scheme == AuthX509 {
+		method = KeyCertMethodX509
+	}
+	if k.Method != "" && !strings.EqualFold(k.Method, method) {
+		err := fmt.Errorf("%w: method does not match %s", ErrInvalidKeyCert, method)
+		errs = append(errs, &ValidationError{Attribute: "method", Value: k.Method, Err: err})
+	}
+	if name.Scheme == AuthX509 || k.Certif == "" {
+		return errors.Join(errs...)
+	}
+	key, err := k.PublicKey()
+	if err != nil {
+		return errors.Join(append(errs, &ValidationError{Attribute: "certif", Err: err})...)
+	}
+	fingerprint := strings.ToUpper(hex.EncodeToString(key.Fingerprint[:]))
+	if !strings.HasSuffix(fingerprint, strings.ToUpper(name.Value)) {
+		err := fmt.Errorf("%w: name does not match key %s", ErrInvalidKeyCert, key.KeyCert())
+		errs = append(errs, &ValidationError{Attribute: "key-cert", Value: k.KeyCert, Err: err})
+	}
+	if k.Owner != "" && !slices.Equal(splitLines(k.Owner), key.UserIDs) {
+		err := fmt.Errorf("%w: owner does not match the key's user IDs", ErrInvalidKeyCert)
+		errs = append(errs, &ValidationError{Attribute: "owner", Value: k.Owner, Err: err})
+	}
+	if k.Fingerprint != "" {
+		got := strings.ToUpper(strings.Join(strings.Fields(k.Fingerprint), ""))
+		if got != fingerprint {
+			err := fmt.Errorf("%w: fingerpr does not match %s", ErrInvalidKeyCert, key.Fingerprint)
+			errs = append(errs, &ValidationError{Attribute: "fingerpr", Value: k.Fingerprint, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,185 @@
+package rpsl_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+const testKeyData = `mDMEatSeXRYJKwYBBAHaRw8BAQdAOCSm4ty9KlnrRyctjNxy7Cc04DHED88DWDdp
+5moT9XO0GkFDTUUgTk9DIDxub2NAZXhhbXBsZS5jb20+iJAEExYIADgWIQQXXQJy
+yQimoejWZnCeUYiIPjOIAQUCatSeXQIbAwULCQgHAgYVCgkICwIEFgIDAQIeAQIX
+gAAKCRCeUYiIPjOIARYSAP9B496lTWoOh16sa6fDs0a2stkXGy28W+2PAQlKpyCr
+SwD/ZI5Vd+xB2wVWZyo+X9nKGtN5/OfLx6M4dWVOKIBTaQA=`
+
+const testKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+` + testKeyData + `
+=DoSP
+-----END PGP PUBLIC KEY BLOCK-----`
+
+// armor wraps packet data in a public key block without a checksum.
+func armor(b []byte) string {
+	return "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n" + base64.StdEncoding.EncodeToString(b) + "\n-----END PGP PUBLIC KEY BLOCK-----"
+}
+
+func Test_ParsePGPKey(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		k, err := rpsl.ParsePGPKey(testKey)
+		require.NoError(t, err)
+		assert.Equal(t, "175D 0272 C908 A6A1 E8D6  6670 9E51 8888 3E33 8801", k.Fingerprint.String())
+		assert.Equal(t, "9E5188883E338801", k.KeyID())
+		assert.Equal(t, "PGPKEY-3E338801", k.KeyCert())
+		assert.Equal(t, []string{"ACME NOC <noc@example.com>"}, k.UserIDs)
+	})
+	t.Run("headers without checksum", func(t *testing.T) {
+		t.Parallel()
+		armored := "-----BEGIN PGP PUBLIC KEY BLOCK-----\nComment: ACME NOC\n\n" + testKeyData + "\n-----END PGP PUBLIC KEY BLOCK-----\n"
+		k, err := rpsl.ParsePGPKey(armored)
+		require.NoError(t, err)
+		assert.Equal(t, "PGPKEY-3E338801", k.KeyCert())
+	})
+	t.Run("without blank line", func(t *testing.T) {
+		t.Parallel()
+		k, err := rpsl.ParsePGPKey(strings.Replace(testKey, "\n\n", "\n", 1))
+		require.NoError(t, err)
+		assert.Equal(t, "PGPKEY-3E338801", k.KeyCert())
+	})
+	data, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(testKeyData, "\n", ""))
+	require.NoError(t, err)
+	errCases := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"no end", "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n" + testKeyData},
+		{"invalid base64", "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n!!!!\n-----END PGP PUBLIC KEY BLOCK-----"},
+		{"checksum mismatch", strings.Replace(testKey, "=DoSP", "=AAAA", 1)},
+		{"malformed header", armor([]byte{0x00})},
+		{"not a public key", armor([]byte{0xc2, 0x01, 0x00})},
+		{"truncated", armor([]byte{0xc6, 0x05, 0x04})},
+		{"truncated length", armor([]byte{0xc6, 0xff, 0x00})},
+		{"partial length", armor([]byte{0xc6, 0xe0})},
+		{"unsupported version", armor([]byte{0xc6, 0x01, 0x03})},
+		// The public key packet alone, i.e. without the user ID and signature packets.
+		{"no user id", armor(data[:53])},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParsePGPKey(c.value)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidKeyCert)
+		})
+	}
+}
+
+func TestKeyCert_RPSL(t *testing.T) {
+	t.Parallel()
+	k := rpsl.KeyCert{
+		KeyCert:     "PGPKEY-3E338801",
+		Method:      "PGP",
+		Owner:       "ACME NOC <noc@example.com>",
+		Fingerprint: "175D 0272 C908 A6A1 E8D6  6670 9E51 8888 3E33 8801",
+		Certif:      testKey,
+		MntBy:       "MNT-ACME",
+		Source:      "TEST",
+	}
+	exp := []byte(`key-cert: PGPKEY-3E338801
+method: PGP
+owner: ACME NOC <noc@example.com>
+fingerpr: 175D 0272 C908 A6A1 E8D6  6670 9E51 8888 3E33 8801
+certif: -----BEGIN PGP PUBLIC KEY BLOCK-----
+certif:
+certif: mDMEatSeXRYJKwYBBAHaRw8BAQdAOCSm4ty9KlnrRyctjNxy7Cc04DHED88DWDdp
+certif: 5moT9XO0GkFDTUUgTk9DIDxub2NAZXhhbXBsZS5jb20+iJAEExYIADgWIQQXXQJy
+certif: yQimoejWZnCeUYiIPjOIAQUCatSeXQIbAwULCQgHAgYVCgkICwIEFgIDAQIeAQIX
+certif: gAAKCRCeUYiIPjOIARYSAP9B496lTWoOh16sa6fDs0a2stkXGy28W+2PAQlKpyCr
+certif: SwD/ZI5Vd+xB2wVWZyo+X9nKGtN5/OfLx6M4dWVOKIBTaQA=
+certif: =DoSP
+certif: -----END PGP PUBLIC KEY BLOCK-----
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&k)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "PGPKEY-3E338801", k.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.KeyCert
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, k, decoded)
+		assert.NoError(t, decoded.Validate())
+	})
+	t.Run("decode continuation", func(t *testing.T) {
+		// Each certif line after the first is a continuation line, with '+' for the blank line.
+		lines := strings.Split(testKey, "\n")
+		certif := "certif: " + lines[0] + "\n+\n"
+		for _, l := range lines[2:] {
+			certif += "        " + l + "\n"
+		}
+		var decoded rpsl.KeyCert
+		err := rpsl.UnmarshalBinary([]byte("key-cert: PGPKEY-3E338801\n"+certif+"source: TEST"), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, testKey, decoded.Certif)
+		assert.NoError(t, decoded.Validate())
+	})
+	t.Run("generate", func(t *testing.T) {
+		generated := rpsl.KeyCert{Certif: testKey}
+		require.NoError(t, generated.Generate())
+		assert.Equal(t, k.KeyCert, generated.KeyCert)
+		assert.Equal(t, k.Method, generated.Method)
+		assert.Equal(t, k.Owner, generated.Owner)
+		assert.Equal(t, k.Fingerprint, generated.Fingerprint)
+	})
+	t.Run("generate error", func(t *testing.T) {
+		generated := rpsl.KeyCert{Certif: "invalid"}
+		assert.ErrorIs(t, generated.Generate(), rpsl.ErrInvalidKeyCert)
+	})
+}
+
+func TestKeyCert_Validate(t *testing.T) {
+	cases := []struct {
+		name string
+		k    rpsl.KeyCert
+		err  error
+	}{
+		{"valid", rpsl.KeyCert{KeyCert: "PGPKEY-3E338801", Certif: testKey}, nil},
+		{"valid key id", rpsl.KeyCert{KeyCert: "PGPKEY-9E5188883E338801", Certif: testKey}, nil},
+		{"valid generated", rpsl.KeyCert{
+			KeyCert:     "PGPKEY-3e338801",
+			Method:      "pgp",
+			Owner:       "ACME NOC <noc@example.com>",
+			Fingerprint: "175d0272c908a6a1e8d666709e5188883e338801",
+			Certif:      testKey,
+		}, nil},
+		{"x509", rpsl.KeyCert{KeyCert: "X509-1", Method: "X509", Certif: "-----BEGIN CERTIFICATE-----"}, nil},
+		{"missing certif", rpsl.KeyCert{KeyCert: "PGPKEY-3E338801"}, rpsl.ErrMissingAttribute},
+		{"missing key-cert", rpsl.KeyCert{Certif: testKey}, rpsl.ErrMissingAttribute},
+		{"invalid name", rpsl.KeyCert{KeyCert: "MD5-PW", Certif: testKey}, rpsl.ErrInvalidKeyCert},
+		{"name mismatch", rpsl.KeyCert{KeyCert: "PGPKEY-1234ABCD", Certif: testKey}, rpsl.ErrInvalidKeyCert},
+		{"method mismatch", rpsl.KeyCert{KeyCert: "PGPKEY-3E338801", Method: "X509", Certif: testKey}, rpsl.ErrInvalidKeyCert},
+		{"owner mismatch", rpsl.KeyCert{KeyCert: "PGPKEY-3E338801", Owner: "John Doe <jd@example.com>", Certif: testKey}, rpsl.ErrInvalidKeyCert},
+		{"fingerpr mismatch", rpsl.KeyCert{KeyCert: "PGPKEY-3E338801", Fingerprint: "0000", Certif: testKey}, rpsl.ErrInvalidKeyCert},
+		{"invalid certif", rpsl.KeyCert{KeyCert: "PGPKEY-3E338801", Certif: "invalid"}, rpsl.ErrInvalidKeyCert},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.k.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package rpsl
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// AuthScheme is a mntner authentication scheme.
+type AuthScheme string
+
+const (
+	// AuthMD5PW authenticates with a password, stored as an MD5-crypt hash, e.g. $1$salt$hash.
+	AuthMD5PW AuthScheme = "MD5-PW"
+	// AuthBcryptPW authenticates with a password, stored as a bcrypt hash, e.g. $2a$12$hash.
+	AuthBcryptPW AuthScheme = "BCRYPT-PW"
+	// AuthPGPKey authenticates with a PGP signature, by the key in a key-cert object, e.g.
+	// PGPKEY-1234ABCD.
+	AuthPGPKey AuthScheme = "PGPKEY"
+	// AuthSSO authenticates with a registry single sign-on account, e.g. RIPE NCC Access.
+	AuthSSO AuthScheme = "SSO"
+	// AuthX509 authenticates with an X.509 certificate, by the certificate in a key-cert object,
+	// e.g. X509-1.
+	AuthX509 AuthScheme = "X509"
+)
+
+var (
+	md5Hash    = regexp.MustCompile(`^\$1\$[./0-9A-Za-z]{1,8}\$[./0-9A-Za-z]{22}$`)
+	bcryptHash = regexp.MustCompile(`^\$2[aby]?\$\d{2}\$[./0-9A-Za-z]{53}$`)
+	pgpKeyID   = regexp.MustCompile(`^(?:[0-9A-Fa-f]{8}|[0-9A-Fa-f]{16}|[0-9A-Fa-f]{40})$`)
+	x509ID     = regexp.MustCompile(`^\d+$`)
+)
+
+// known determines if the scheme is one of the AuthScheme constants.
+func (s AuthScheme) known() bool {
+	switch s {
+	case AuthMD5PW, AuthBcryptPW, AuthPGPKey, AuthSSO, AuthX509:
+		return true
+	}
+	return false
+}
+
+// Auth is a mntner auth value, e.g. MD5-PW $1$salt$hash or PGPKEY-1234ABCD.
+type Auth struct {
+	// Authentication scheme.
+	Scheme AuthScheme
+	// Scheme-specific credential: the password hash for MD5-PW and BCRYPT-PW, the key ID for
+	// PGPKEY, the certificate number for X509, or the account for SSO. Empty if filtered.
+	Value string
+	// Filtered indicates that the credential has been removed, as registries do for password
+	// hashes and SSO accounts, e.g. MD5-PW # Filtered.
+	Filtered bool
+}
+
+// ParseAuth parses a mntner auth value, e.g. BCRYPT-PW $2a$12$..., PGPKEY-1234ABCD, X509-1, or
+// MD5-PW # Filtered. Values with a scheme other than the AuthScheme constants, such as CRYPT-PW or
+// MAIL-FROM in older registries, are kept with their scheme and credential as written; they are
+// reported by Mntner.Validate.
+func ParseAuth(s string) (Auth, error) {
+	value, comment, _ := strings.Cut(strings.TrimSpace(s), "#")
+	scheme, credential, _ := strings.Cut(strings.TrimSpace(value), " ")
+	a := Auth{
+		Value:    strings.TrimSpace(credential),
+		Filtered: strings.EqualFold(strings.TrimSpace(comment), "Filtered"),
+	}
+	switch upper := strings.ToUpper(scheme); {
+	case upper == string(AuthMD5PW), upper == string(AuthBcryptPW), upper == string(AuthSSO):
+		a.Scheme = AuthScheme(upper)
+	case strings.HasPrefix(upper, string(AuthPGPKey)+"-"):
+		a.Scheme, a.Value = AuthPGPKey, strings.TrimSpace(upper[len(AuthPGPKey)+1:]+" "+a.Value)
+	case strings.HasPrefix(upper, string(AuthX509)+"-"):
+		a.Scheme, a.Value = AuthX509, strings.TrimSpace(upper[len(AuthX509)+1:]+" "+a.Value)
+	case upper == "":
+		return Auth{}, fmt.Errorf("%w: value '%s' has no scheme", ErrInvalidAuth, s)
+	default:
+		a.Scheme = AuthScheme(scheme)
+		return a, nil
+	}
+	if err := a.check(); err != nil {
+		return Auth{}, err
+	}
+	return a, nil
+}
+
+// check checks that the auth value's credential is well-formed for its scheme.
+func (a Auth) check() error {
+	if !a.Scheme.known() {
+		return fmt.Errorf("%w: unknown scheme '%s'", ErrInvalidAuth, a.Scheme)
+	}
+	if a.Filtered {
+		if a.Value != "" {
+			return fmt.Errorf("%w: filtered %s has a value", ErrInvalidAuth, a.Scheme)
+		}
+		if !a.IsSecret() {
+			return fmt.Errorf("%w: %s cannot be filtered", ErrInvalidAuth, a.Scheme)
+		}
+		return nil
+	}
+	var valid bool
+	switch a.Scheme {
+	case AuthMD5PW:
+		valid = md5Hash.MatchString(a.Value)
+	case AuthBcryptPW:
+		valid = bcryptHash.MatchString(a.Value)
+	case AuthPGPKey:
+		valid = pgpKeyID.MatchString(a.Value)
+	case AuthX509:
+		valid = x509ID.MatchString(a.Value)
+	case AuthSSO:
+		valid = a.Value != "" && !strings.ContainsAny(a.Value, " \t")
+	}
+	if !valid {
+		return fmt.Errorf("%w: malformed %s credential", ErrInvalidAuth, a.Scheme)
+	}
+	return nil
+}
+
+// IsSecret determines if the auth value's credential is secret, i.e. a password hash or an SSO
+// account, and should be filtered before the mntner is published.
+func (a Auth) IsSecret() bool {
+	switch a.Scheme {
+	case AuthMD5PW, AuthBcryptPW, AuthSSO:
+		return true
+	}
+	return false
+}
+
+// Filter returns the auth value with any secret credential removed. Other auth values are
+// returned unchanged.
+func (a Auth) Filter() Auth {
+	if !a.IsSecret() {
+		return a
+	}
+	return Auth{Scheme: a.Scheme, Filtered: true}
+}
+
+// KeyCert returns the name of the key-cert object referenced by a PGPKEY or X509 auth value, e.g.
+// PGPKEY-1234ABCD. An empty string is returned for other schemes.
+func (a Auth) KeyCert() string {
+	switch a.Scheme {
+	case AuthPGPKey, AuthX509:
+		return string(a.Scheme) + "-" + a.Value
+	}
+	return ""
+}
+
+// String represents the auth value in RPSL format, e.g. MD5-PW # Filtered.
+func (a Auth) String() string {
+	switch {
+	case a.Filtered:
+		return string(a.Scheme) + " # Filtered"
+	case a.KeyCert() != "":
+		return a.KeyCert()
+	}
+	return string(a.Scheme) + " " + a.Value
+}
+
+// MarshalBinary encodes the auth value in RPSL format.
+func (a Auth) MarshalBinary() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an Auth type.
+func (a Auth) UnmarshalBinary(b []byte) (Auth, error) {
+	return ParseAuth(string(b))
+}
+
+// Mntner is an RPSL 'mntner class' object. A maintainer controls who may create, modify, or
+// delete the objects that refer to it in their mnt-by attributes, by way of its auth values.
+type Mntner struct {
+	// Name of the maintainer, e.g. MNT-ACME.
+	//    *Required
+	Mntner string `rpsl:"mntner,mandatory"`
+	// Description for the mntner object.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// E-mail addresses notified of failed update attempts. Multiple addresses are separated by
+	// newlines.
+	//    *Required
+	UpdTo string `rpsl:"upd-to,mandatory,multiple" as:"multiline"`
+	// E-mail addresses notified of successful updates to objects maintained by the mntner. Multiple
+	// addresses are separated by newlines.
+	MntNfy string `rpsl:"mnt-nfy,omitempty,multiple" as:"multiline"`
+	// Authentication values, any of which authorizes an update.
+	//    *Required
+	Auth []Auth `rpsl:"auth,mandatory,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// E-mail addresses notified of updates to the mntner object itself. Multiple addresses are
+	// separated by newlines.
+	Notify string `rpsl:"notify,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the mntner object itself; often the mntner itself.
+	// Multiple maintainers are separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the mntner object.
+func (m *Mntner) AddExtra(key, value string) {
+	m.Extra.Add(key, value)
+}
+
+// String representation of the mntner in RPSL format. E.g. MNT-ACME.
+func (m *Mntner) String() string {
+	return m.Mntner
+}
+
+// Filter returns a copy of the mntner with secret auth credentials removed, as registries publish
+// them, e.g. MD5-PW # Filtered.
+func (m *Mntner) Filter() *Mntner {
+	filtered := *m
+	filtered.Auth = make([]Auth, len(m.Auth))
+	for i, a := range m.Auth {
+		filtered.Auth[i] = a.Filter()
+	}
+	return &filtered
+}
+
+// Validate validates the mntner object, returning all problems found. The mntner name must be a
+// valid object name, each auth value must have a known scheme and be well-formed for it, and
+// upd-to, mnt-nfy, and notify values must be e-mail addresses.
+func (m *Mntner) Validate() error {
+	errs := validateMandatory(m)
+	if m.Mntner != "" {
+		errs = append(errs, validateNames("mntner", m.Mntner)...)
+	}
+	for _, a := range m.Auth {
+		if err := a.check(); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "auth", Value: a.String(), Err: err})
+		}
+	}
+	errs = append(errs, validateEmails("upd-to", m.UpdTo)...)
+	errs = append(errs, validateEmails("mnt-nfy", m.MntNfy)...)
+	errs = append(errs, validateEmails("notify", m.Notify)...)
+	errs = append(errs, validateContacts(m.AdminPOC, m.TechPOC, m.MntBy)...)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,233 @@
+package rpsl_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+const (
+	testMD5Hash    = "$1$abcdefgh$cHJi5PXp/ki/ktXzqlk6I1"
+	testBcryptHash = "$2a$12$R9h/cIPz0gi.URNNX3kh2OPST9/PgBkqquzi.Ss7KIUgO2t0jWMUW"
+)
+
+func Test_ParseAuth(t *testing.T) {
+	cases := []struct {
+		in     string
+		exp    rpsl.Auth
+		format string
+	}{
+		{"MD5-PW " + testMD5Hash, rpsl.Auth{Scheme: rpsl.AuthMD5PW, Value: testMD5Hash}, ""},
+		{"md5-pw " + testMD5Hash, rpsl.Auth{Scheme: rpsl.AuthMD5PW, Value: testMD5Hash}, "MD5-PW " + testMD5Hash},
+		{"BCRYPT-PW " + testBcryptHash, rpsl.Auth{Scheme: rpsl.AuthBcryptPW, Value: testBcryptHash}, ""},
+		{"PGPKEY-1234ABCD", rpsl.Auth{Scheme: rpsl.AuthPGPKey, Value: "1234ABCD"}, ""},
+		{"pgpkey-1234abcd", rpsl.Auth{Scheme: rpsl.AuthPGPKey, Value: "1234ABCD"}, "PGPKEY-1234ABCD"},
+		{"X509-1", rpsl.Auth{Scheme: rpsl.AuthX509, Value: "1"}, ""},
+		{"SSO user@example.com", rpsl.Auth{Scheme: rpsl.AuthSSO, Value: "user@example.com"}, ""},
+		{"MD5-PW # Filtered", rpsl.Auth{Scheme: rpsl.AuthMD5PW, Filtered: true}, ""},
+		{"BCRYPT-PW # Filtered", rpsl.Auth{Scheme: rpsl.AuthBcryptPW, Filtered: true}, ""},
+		{"SSO # Filtered", rpsl.Auth{Scheme: rpsl.AuthSSO, Filtered: true}, ""},
+		{"SSO #filtered", rpsl.Auth{Scheme: rpsl.AuthSSO, Filtered: true}, "SSO # Filtered"},
+		{"CRYPT-PW abcdefgh", rpsl.Auth{Scheme: "CRYPT-PW", Value: "abcdefgh"}, ""},
+		{"MAIL-FROM noc@example.com", rpsl.Auth{Scheme: "MAIL-FROM", Value: "noc@example.com"}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			a, err := rpsl.ParseAuth(c.in)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, a)
+			exp := c.format
+			if exp == "" {
+				exp = c.in
+			}
+			assert.Equal(t, exp, a.String())
+		})
+	}
+	errCases := []string{
+		"",
+		"# Filtered",
+		"MD5-PW",
+		"MD5-PW plaintext",
+		"BCRYPT-PW " + testMD5Hash,
+		"PGPKEY-XYZ",
+		"PGPKEY-1234ABCD extra",
+		"X509-A",
+		"SSO",
+		"PGPKEY-1234ABCD # Filtered",
+		"MD5-PW " + testMD5Hash + " # Filtered",
+	}
+	for _, c := range errCases {
+		t.Run("err "+c, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseAuth(c)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidAuth)
+		})
+	}
+}
+
+func TestAuth_Filter(t *testing.T) {
+	cases := []struct {
+		in  string
+		exp string
+	}{
+		{"MD5-PW " + testMD5Hash, "MD5-PW # Filtered"},
+		{"BCRYPT-PW " + testBcryptHash, "BCRYPT-PW # Filtered"},
+		{"SSO user@example.com", "SSO # Filtered"},
+		{"PGPKEY-1234ABCD", "PGPKEY-1234ABCD"},
+		{"X509-1", "X509-1"},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			a, err := rpsl.ParseAuth(c.in)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, a.Filter().String())
+		})
+	}
+	t.Run("key-cert", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "PGPKEY-1234ABCD", rpsl.Auth{Scheme: rpsl.AuthPGPKey, Value: "1234ABCD"}.KeyCert())
+		assert.Equal(t, "X509-1", rpsl.Auth{Scheme: rpsl.AuthX509, Value: "1"}.KeyCert())
+		assert.Empty(t, rpsl.Auth{Scheme: rpsl.AuthSSO, Value: "user@example.com"}.KeyCert())
+	})
+}
+
+func TestMntner_RPSL(t *testing.T) {
+	t.Parallel()
+	m := rpsl.Mntner{
+		Mntner:      "MNT-ACME",
+		Description: "ACME maintainer",
+		AdminPOC:    "ACME-ADMIN",
+		TechPOC:     "ACME-TECH",
+		UpdTo:       "noc@example.com",
+		MntNfy:      "noc@example.com",
+		Auth: []rpsl.Auth{
+			{Scheme: rpsl.AuthBcryptPW, Value: testBcryptHash},
+			{Scheme: rpsl.AuthPGPKey, Value: "1234ABCD"},
+			{Scheme: rpsl.AuthSSO, Value: "user@example.com"},
+		},
+		MntBy:  "MNT-ACME",
+		Source: "RIPE",
+	}
+	exp := []byte(`mntner: MNT-ACME
+descr: ACME maintainer
+admin-c: ACME-ADMIN
+tech-c: ACME-TECH
+upd-to: noc@example.com
+mnt-nfy: noc@example.com
+auth: BCRYPT-PW ` + testBcryptHash + `
+auth: PGPKEY-1234ABCD
+auth: SSO user@example.com
+mnt-by: MNT-ACME
+source: RIPE`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.EncodeOptions{Secrets: true}.MarshalBinary(&m)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("filtered by default", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&m)
+		require.NoError(t, err)
+		assert.Contains(t, string(result), "\nauth: BCRYPT-PW # Filtered\n")
+		assert.NotContains(t, string(result), testBcryptHash)
+		var buf bytes.Buffer
+		enc := rpsl.NewEncoder(&buf)
+		require.NoError(t, enc.Encode(&m))
+		assert.Equal(t, string(result)+"\n", buf.String())
+		buf.Reset()
+		enc = rpsl.NewEncoder(&buf)
+		enc.SetOptions(rpsl.EncodeOptions{Secrets: true})
+		require.NoError(t, enc.Encode(&m))
+		assert.Equal(t, string(exp)+"\n", buf.String())
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "MNT-ACME", m.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.Mntner
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, m, decoded)
+	})
+	t.Run("filter", func(t *testing.T) {
+		filtered := m.Filter()
+		result, err := rpsl.MarshalBinary(filtered)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`mntner: MNT-ACME
+descr: ACME maintainer
+admin-c: ACME-ADMIN
+tech-c: ACME-TECH
+upd-to: noc@example.com
+mnt-nfy: noc@example.com
+auth: BCRYPT-PW # Filtered
+auth: PGPKEY-1234ABCD
+auth: SSO # Filtered
+mnt-by: MNT-ACME
+source: RIPE`), result)
+		// The original is unchanged.
+		assert.Equal(t, testBcryptHash, m.Auth[0].Value)
+	})
+	t.Run("decode filtered", func(t *testing.T) {
+		var decoded rpsl.Mntner
+		err := rpsl.UnmarshalBinary([]byte("mntner: MNT-ACME\nauth: MD5-PW # Filtered\nauth: SSO # Filtered"), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, []rpsl.Auth{{Scheme: rpsl.AuthMD5PW, Filtered: true}, {Scheme: rpsl.AuthSSO, Filtered: true}}, decoded.Auth)
+	})
+	t.Run("decode unknown scheme", func(t *testing.T) {
+		obj, err := rpsl.UnmarshalObject([]byte("mntner: MNT-ACME\nauth: CRYPT-PW abcdefgh\nsource: RADB"))
+		require.NoError(t, err)
+		decoded, ok := obj.(*rpsl.Mntner)
+		require.True(t, ok)
+		assert.Equal(t, []rpsl.Auth{{Scheme: "CRYPT-PW", Value: "abcdefgh"}}, decoded.Auth)
+		assert.ErrorIs(t, decoded.Validate(), rpsl.ErrInvalidAuth)
+	})
+	t.Run("err decode", func(t *testing.T) {
+		var decoded rpsl.Mntner
+		err := rpsl.UnmarshalBinary([]byte("mntner: MNT-ACME\nauth: MD5-PW plaintext"), &decoded)
+		assert.ErrorIs(t, err, rpsl.ErrInvalidAuth)
+	})
+}
+
+func TestMntner_Validate(t *testing.T) {
+	valid := func() rpsl.Mntner {
+		return rpsl.Mntner{
+			Mntner: "MNT-ACME",
+			UpdTo:  "noc@example.com",
+			Auth:   []rpsl.Auth{{Scheme: rpsl.AuthPGPKey, Value: "1234ABCD"}},
+			MntBy:  "MNT-ACME",
+		}
+	}
+	cases := []struct {
+		name   string
+		modify func(m *rpsl.Mntner)
+		err    error
+	}{
+		{"valid", func(m *rpsl.Mntner) {}, nil},
+		{"missing auth", func(m *rpsl.Mntner) { m.Auth = nil }, rpsl.ErrMissingAttribute},
+		{"missing upd-to", func(m *rpsl.Mntner) { m.UpdTo = "" }, rpsl.ErrMissingAttribute},
+		{"invalid name", func(m *rpsl.Mntner) { m.Mntner = "MNT ACME" }, rpsl.ErrInvalidName},
+		{"invalid auth", func(m *rpsl.Mntner) { m.Auth[0].Value = "plaintext" }, rpsl.ErrInvalidAuth},
+		{"unknown auth scheme", func(m *rpsl.Mntner) { m.Auth[0] = rpsl.Auth{Scheme: "CRYPT-PW", Value: "abcdefgh"} }, rpsl.ErrInvalidAuth},
+		{"invalid mnt-by", func(m *rpsl.Mntner) { m.MntBy = "ANY" }, rpsl.ErrInvalidName},
+		{"invalid upd-to", func(m *rpsl.Mntner) { m.UpdTo = "NOC <noc@example.com>" }, rpsl.ErrInvalidEmail},
+		{"invalid mnt-nfy", func(m *rpsl.Mntner) { m.MntNfy = "noc" }, rpsl.ErrInvalidEmail},
+		{"invalid notify", func(m *rpsl.Mntner) { m.Notify = "noc@example.com\nnoc" }, rpsl.ErrInvalidEmail},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			m := valid()
+			c.modify(&m)
+			err := m.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
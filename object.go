@@ -0,0 +1,114 @@
+package rpsl
+
+import (
+	"strings"
+
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+// Object is a schema-less RPSL object of any class, e.g. mntner, person, or inetnum. The first
+// attribute of the object determines its class name; all attributes, including the first, are held
+// in order.
+type Object struct {
+	// All attributes of the object, in order.
+	Attributes Attributes `rpsl:"-"`
+}
+
+// primaryKeys are the attributes forming the primary key of classes whose key is not the value of
+// the class attribute, per RFC 2622 and RFC 4012.
+var primaryKeys = map[string][]string{
+	"route":  {"route", "origin"},
+	"route6": {"route6", "origin"},
+	"person": {"nic-hdl"},
+	"role":   {"nic-hdl"},
+}
+
+// NewObject creates an RPSL object of the given class, with the given value of the class
+// attribute, e.g. a person's name.
+func NewObject(class, key string) *Object {
+	o := &Object{}
+	o.Attributes.Add(class, key)
+	return o
+}
+
+// ToObject converts an RPSL struct, e.g. *rpsl.Route, to a generic object. The argument must be a
+// pointer to an RPSL struct.
+func ToObject(v any) (*Object, error) {
+	b, err := serialize.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	o := &Object{}
+	if err := serialize.Decode(b, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// FromObject converts a generic object to an RPSL struct. The second argument must be a pointer to
+// an RPSL struct.
+func FromObject(o *Object, v any) error {
+	b, err := serialize.Encode(o)
+	if err != nil {
+		return err
+	}
+	return serialize.Decode(b, v)
+}
+
+// Class returns the object's class name, e.g. mntner.
+func (o *Object) Class() string {
+	if len(o.Attributes) == 0 {
+		return ""
+	}
+	return o.Attributes[0].Key
+}
+
+// Key returns the object's primary key. For most classes, this is the value of the object's first
+// attribute, e.g. MNT-ACME. The key of a person or role object is its nic-hdl, and the key of a
+// route or route6 object is its prefix followed by its origin, e.g. 192.0.2.0/24AS65000.
+func (o *Object) Key() string {
+	if len(o.Attributes) == 0 {
+		return ""
+	}
+	attrs, ok := primaryKeys[strings.ToLower(o.Class())]
+	if !ok {
+		return o.Attributes[0].Value
+	}
+	var key strings.Builder
+	for _, attr := range attrs {
+		key.WriteString(o.Get(attr))
+	}
+	return key.String()
+}
+
+// Get returns the value of the first attribute matching key, or an empty string if no attribute
+// matches.
+func (o *Object) Get(key string) string {
+	return o.Attributes.Get(key)
+}
+
+// GetAll returns the values of all attributes matching key, in order.
+func (o *Object) GetAll(key string) []string {
+	return o.Attributes.GetAll(key)
+}
+
+// Set sets the value of the first attribute matching key and removes any other attributes
+// matching key. If no attribute matches key, the attribute is appended to the object.
+func (o *Object) Set(key, value string) {
+	o.Attributes.Set(key, value)
+}
+
+// Add appends an attribute to the object.
+func (o *Object) Add(key, value string) {
+	o.Attributes.Add(key, value)
+}
+
+// Delete removes all attributes matching key.
+func (o *Object) Delete(key string) {
+	o.Attributes.Delete(key)
+}
+
+// String representation of the object in RPSL format, i.e. its primary key.
+func (o *Object) String() string {
+	return o.Key()
+}
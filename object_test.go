@@ -0,0 +1,106 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_Object(t *testing.T) {
+	t.Run("decode", func(t *testing.T) {
+		t.Parallel()
+		b := []byte(`mntner: MNT-ACME
+descr: ACME
+admin-c: ACME-ADMIN
+auth: MD5-PW # Filtered
+auth: SSO # Filtered
+mnt-by: MNT-ACME
+source: RIPE`)
+		var o rpsl.Object
+		err := rpsl.UnmarshalBinary(b, &o)
+		require.NoError(t, err)
+		assert.Equal(t, "mntner", o.Class())
+		assert.Equal(t, "MNT-ACME", o.Key())
+		assert.Equal(t, "MNT-ACME", o.String())
+		assert.Equal(t, "ACME", o.Get("descr"))
+		assert.Equal(t, []string{"MD5-PW # Filtered", "SSO # Filtered"}, o.GetAll("auth"))
+		result, err := rpsl.MarshalBinary(&o)
+		require.NoError(t, err)
+		assert.Equal(t, b, result)
+	})
+	t.Run("mixed case", func(t *testing.T) {
+		t.Parallel()
+		obj, err := rpsl.UnmarshalObject([]byte("mystery: X\nRemarks: a"))
+		require.NoError(t, err)
+		o, ok := obj.(*rpsl.Object)
+		require.True(t, ok)
+		assert.Equal(t, "a", o.Get("remarks"))
+		route := rpsl.Object{Attributes: rpsl.Attributes{
+			{Key: "Route", Value: "192.0.2.0/24"},
+			{Key: "Origin", Value: "AS65000"},
+		}}
+		assert.Equal(t, "192.0.2.0/24AS65000", route.Key())
+	})
+	t.Run("new", func(t *testing.T) {
+		t.Parallel()
+		o := rpsl.NewObject("person", "John Doe")
+		o.Add("nic-hdl", "JD1-TEST")
+		o.Add("remarks", "one")
+		o.Add("remarks", "two")
+		o.Add("source", "TEST")
+		o.Set("remarks", "three")
+		o.Delete("source")
+		assert.Equal(t, "JD1-TEST", o.Key())
+		exp := []byte(`person: John Doe
+nic-hdl: JD1-TEST
+remarks: three`)
+		result, err := rpsl.MarshalBinary(o)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		var o rpsl.Object
+		assert.Equal(t, "", o.Class())
+		assert.Equal(t, "", o.Key())
+	})
+	t.Run("to object", func(t *testing.T) {
+		t.Parallel()
+		r := &rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000, Source: "ARIN"}
+		r.AddExtra("notify", "noc@example.com")
+		o, err := rpsl.ToObject(r)
+		require.NoError(t, err)
+		assert.Equal(t, "route", o.Class())
+		assert.Equal(t, "192.0.2.0/24AS65000", o.Key())
+		assert.Equal(t, "AS65000", o.Get("origin"))
+		assert.Equal(t, "noc@example.com", o.Get("notify"))
+		assert.Equal(t, "ARIN", o.Get("source"))
+	})
+	t.Run("from object", func(t *testing.T) {
+		t.Parallel()
+		o := rpsl.NewObject("route6", "2001:db8::/32")
+		o.Add("origin", "AS65000")
+		o.Add("notify", "noc@example.com")
+		var r rpsl.Route6
+		err := rpsl.FromObject(o, &r)
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8::/32", r.Route6.String())
+		assert.Equal(t, rpsl.ASN(65000), r.Origin)
+		assert.Equal(t, "noc@example.com", r.Extra.Get("notify"))
+	})
+	t.Run("err to object", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.ToObject("non-struct")
+		assert.Error(t, err)
+	})
+	t.Run("err from object", func(t *testing.T) {
+		t.Parallel()
+		o := rpsl.NewObject("route", "192.0.2.0/24")
+		o.Add("origin", "ASX")
+		var r rpsl.Route
+		err := rpsl.FromObject(o, &r)
+		assert.ErrorContains(t, err, "origin")
+	})
+}
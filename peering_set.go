@@ -0,0 +1,78 @@
+package rpsl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PeeringSet is an RPSL 'peering-set class' object. A peering-set defines a set of peerings, which
+// is referred to by name in the policies of aut-num objects. See RFC 2622 section 5.6 and RFC 4012
+// section 2.5.
+type PeeringSet struct {
+	// Name of the peering-set. Begins with PRNG-, and may be hierarchical, e.g.
+	// AS65000:PRNG-TRANSIT.
+	//    *Required
+	PeeringSet string `rpsl:"peering-set,mandatory"`
+	// Description for the peering-set object.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// IPv4 peering expressions, separated by newlines, e.g. AS65001 192.0.2.1 at 192.0.2.2.
+	Peering string `rpsl:"peering,omitempty,multiple" as:"repeated"`
+	// IPv4 or IPv6 peering expressions, separated by newlines, e.g. AS65001 2001:db8::1 at
+	// 2001:db8::2.
+	MPPeering string `rpsl:"mp-peering,omitempty,multiple" as:"repeated"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the peering-set object. Multiple maintainers are separated
+	// by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the peering-set object.
+func (p *PeeringSet) AddExtra(key, value string) {
+	p.Extra.Add(key, value)
+}
+
+// String representation of the peering-set in RPSL format. E.g. PRNG-TRANSIT or
+// AS65000:PRNG-TRANSIT.
+func (p *PeeringSet) String() string {
+	if n, err := ParseSetName(p.PeeringSet, PeeringSetPrefix); err == nil {
+		return n.String()
+	}
+	return PeeringSetName(p.PeeringSet)
+}
+
+// Validate validates the peering-set object, returning all problems found. The peering-set name
+// must comply with RFC 2622 section 5, at least one peering or mp-peering must be present, and each
+// must begin with an AS expression or a peering-set name.
+func (p *PeeringSet) Validate() error {
+	errs := validateMandatory(p)
+	if p.PeeringSet != "" {
+		if err := validateSetName("peering-set", p.PeeringSet, PeeringSetPrefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if p.Peering == "" && p.MPPeering == "" {
+		err := fmt.Errorf("%w: one of peering or mp-peering is required", ErrMissingAttribute)
+		errs = append(errs, &ValidationError{Attribute: "peering", Err: err})
+	}
+	for _, v := range splitLines(p.Peering) {
+		if err := validatePeering("peering", v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, v := range splitLines(p.MPPeering) {
+		if err := validatePeering("mp-peering", v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateContacts(p.AdminPOC, p.TechPOC, p.MntBy)...)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,73 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestPeeringSet_RPSL(t *testing.T) {
+	t.Parallel()
+	p := rpsl.PeeringSet{
+		PeeringSet: "PRNG-TRANSIT",
+		Peering: `AS65001 192.0.2.1 at 192.0.2.2
+AS65002 198.51.100.1 at 198.51.100.2`,
+		MPPeering: "AS65001 2001:db8::1 at 2001:db8::2",
+		MntBy:     "MNT-ACME",
+		Source:    "TEST",
+	}
+	exp := []byte(`peering-set: PRNG-TRANSIT
+peering: AS65001 192.0.2.1 at 192.0.2.2
+peering: AS65002 198.51.100.1 at 198.51.100.2
+mp-peering: AS65001 2001:db8::1 at 2001:db8::2
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&p)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "PRNG-TRANSIT", p.String())
+		assert.Equal(t, "AS65000:PRNG-TRANSIT", (&rpsl.PeeringSet{PeeringSet: "AS65000:PRNG-TRANSIT"}).String())
+		assert.Equal(t, "PRNG-TRANSIT", (&rpsl.PeeringSet{PeeringSet: "TRANSIT"}).String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.PeeringSet
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, p, decoded)
+	})
+}
+
+func TestPeeringSet_Validate(t *testing.T) {
+	cases := []struct {
+		name string
+		p    rpsl.PeeringSet
+		err  error
+	}{
+		{"valid", rpsl.PeeringSet{PeeringSet: "PRNG-TRANSIT", Peering: "AS65001 192.0.2.1 at 192.0.2.2"}, nil},
+		{"valid mp-peering", rpsl.PeeringSet{PeeringSet: "AS65000:PRNG-TRANSIT", MPPeering: "AS-ACME at 2001:db8::2"}, nil},
+		{"valid peering-set reference", rpsl.PeeringSet{PeeringSet: "PRNG-ALL", Peering: "PRNG-TRANSIT\nAS-ANY"}, nil},
+		{"valid expression", rpsl.PeeringSet{PeeringSet: "PRNG-ALL", Peering: "(AS65001 OR AS65002) at 192.0.2.2"}, nil},
+		{"missing peering-set", rpsl.PeeringSet{Peering: "AS65001"}, rpsl.ErrMissingAttribute},
+		{"missing peering", rpsl.PeeringSet{PeeringSet: "PRNG-TRANSIT"}, rpsl.ErrMissingAttribute},
+		{"invalid name", rpsl.PeeringSet{PeeringSet: "TRANSIT", Peering: "AS65001"}, rpsl.ErrInvalidSetName},
+		{"invalid peering", rpsl.PeeringSet{PeeringSet: "PRNG-TRANSIT", Peering: "192.0.2.1 at 192.0.2.2"}, rpsl.ErrInvalidPeering},
+		{"unbalanced peering", rpsl.PeeringSet{PeeringSet: "PRNG-TRANSIT", MPPeering: "(AS65001 OR AS65002 at 2001:db8::2"}, rpsl.ErrInvalidPeering},
+		{"invalid tech-c", rpsl.PeeringSet{PeeringSet: "PRNG-TRANSIT", Peering: "AS65001", TechPOC: "JD 1"}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.p.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
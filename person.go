@@ -0,0 +1,67 @@
+package rpsl
+
+import "errors"
+
+// Person is an RPSL 'person class' object. A person object describes the contact information of
+// a person, who is referred to by their NIC handle in the admin-c and tech-c attributes of other
+// objects.
+type Person struct {
+	// Full name of the person, e.g. John Doe.
+	//    *Required
+	Person string `rpsl:"person,mandatory"`
+	// Postal address, one line per attribute.
+	//    *Required
+	Address string `rpsl:"address,mandatory,multiple" as:"multiline"`
+	// Telephone numbers in international format, e.g. +1 555 0100. Multiple numbers are separated by
+	// newlines.
+	//    *Required
+	Phone string `rpsl:"phone,mandatory,multiple" as:"multiline"`
+	// Fax numbers in international format. Multiple numbers are separated by newlines.
+	FaxNo string `rpsl:"fax-no,omitempty,multiple" as:"multiline"`
+	// E-mail addresses. Multiple addresses are separated by newlines.
+	Email string `rpsl:"e-mail,omitempty,multiple" as:"multiline"`
+	// NIC handle of the person, e.g. JD1-RIPE.
+	//    *Required
+	NicHdl string `rpsl:"nic-hdl,mandatory"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// E-mail addresses notified of updates to the person object. Multiple addresses are separated by
+	// newlines.
+	Notify string `rpsl:"notify,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the person object. Multiple maintainers are separated by
+	// newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the person object.
+func (p *Person) AddExtra(key, value string) {
+	p.Extra.Add(key, value)
+}
+
+// String representation of the person in RPSL format, which is the NIC handle by which other
+// objects refer to it. E.g. JD1-RIPE.
+func (p *Person) String() string {
+	return p.NicHdl
+}
+
+// Validate validates the person object, returning all problems found. The nic-hdl must be a valid
+// NIC handle, phone and fax-no values must be telephone numbers in international format, and
+// e-mail and notify values must be e-mail addresses.
+func (p *Person) Validate() error {
+	errs := validateMandatory(p)
+	if p.NicHdl != "" {
+		if err := validateNicHdl("nic-hdl", p.NicHdl); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validatePhones("phone", p.Phone)...)
+	errs = append(errs, validatePhones("fax-no", p.FaxNo)...)
+	errs = append(errs, validateEmails("e-mail", p.Email)...)
+	errs = append(errs, validateEmails("notify", p.Notify)...)
+	errs = append(errs, validateNames("mnt-by", p.MntBy)...)
+	return errors.Join(errs...)
+}
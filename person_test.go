@@ -0,0 +1,115 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestPerson_RPSL(t *testing.T) {
+	t.Parallel()
+	p := rpsl.Person{
+		Person: "John Doe",
+		Address: `123 Name Street
+City, ST 12345
+US`,
+		Phone:  "+1 555 0100",
+		Email:  "jdoe@example.com",
+		NicHdl: "JD1-TEST",
+		MntBy:  "MNT-ACME",
+		Source: "TEST",
+	}
+	exp := []byte(`person: John Doe
+address: 123 Name Street
+address: City, ST 12345
+address: US
+phone: +1 555 0100
+e-mail: jdoe@example.com
+nic-hdl: JD1-TEST
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&p)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "JD1-TEST", p.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.Person
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, p, decoded)
+	})
+	t.Run("with extra", func(t *testing.T) {
+		var decoded rpsl.Person
+		err := rpsl.UnmarshalBinary(append(exp, []byte("\ncreated: 2024-01-01T00:00:00Z")...), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-01T00:00:00Z", decoded.Extra.Get("created"))
+	})
+}
+
+func TestPerson_Validate(t *testing.T) {
+	valid := func() rpsl.Person {
+		return rpsl.Person{
+			Person:  "John Doe",
+			Address: "123 Name Street",
+			Phone:   "+1 555 0100 ext. 42",
+			FaxNo:   "+1 (555) 0101",
+			Email:   "jdoe@example.com\njohn.doe@example.net",
+			NicHdl:  "JD1-TEST",
+			MntBy:   "MNT-ACME",
+		}
+	}
+	cases := []struct {
+		name   string
+		modify func(p *rpsl.Person)
+		err    error
+	}{
+		{"valid", func(p *rpsl.Person) {}, nil},
+		{"missing person", func(p *rpsl.Person) { p.Person = "" }, rpsl.ErrMissingAttribute},
+		{"missing nic-hdl", func(p *rpsl.Person) { p.NicHdl = "" }, rpsl.ErrMissingAttribute},
+		{"missing phone", func(p *rpsl.Person) { p.Phone = "" }, rpsl.ErrMissingAttribute},
+		{"invalid nic-hdl", func(p *rpsl.Person) { p.NicHdl = "JD 1" }, rpsl.ErrInvalidNicHdl},
+		{"invalid phone", func(p *rpsl.Person) { p.Phone = "555 0100" }, rpsl.ErrInvalidPhone},
+		{"invalid fax-no", func(p *rpsl.Person) { p.FaxNo = "+1 555 0100 fax" }, rpsl.ErrInvalidPhone},
+		{"invalid e-mail", func(p *rpsl.Person) { p.Email = "jdoe@example.com\njdoe" }, rpsl.ErrInvalidEmail},
+		{"e-mail with display name", func(p *rpsl.Person) { p.Email = "John Doe <jdoe@example.com>" }, rpsl.ErrInvalidEmail},
+		{"invalid mnt-by", func(p *rpsl.Person) { p.MntBy = "MNT ACME" }, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			p := valid()
+			c.modify(&p)
+			err := p.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func Test_NicHdl(t *testing.T) {
+	valid := []string{"JD1-TEST", "jd1-ripe", "DOEJO-ARIN", "ACME-ADMIN", "AA1", "JD123456-AP", "AUTO-1", "AUTO-12JD"}
+	for _, v := range valid {
+		t.Run(v, func(t *testing.T) {
+			t.Parallel()
+			p := rpsl.Person{Person: "John Doe", Address: "x", Phone: "+1 555 0100", NicHdl: v}
+			assert.NoError(t, p.Validate())
+		})
+	}
+	invalid := []string{"J", "1JD", "JD1234567", "JD1-", "JD1-T", "JD-1TEST", "JD 1", "AUTO-"}
+	for _, v := range invalid {
+		t.Run("err "+v, func(t *testing.T) {
+			t.Parallel()
+			p := rpsl.Person{Person: "John Doe", Address: "x", Phone: "+1 555 0100", NicHdl: v}
+			assert.ErrorIs(t, p.Validate(), rpsl.ErrInvalidNicHdl)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package rpsl
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// checkPrefix checks that an IP prefix is valid, of the given family (4 or 6, or 0 for either),
+// and has no host bits set.
+func checkPrefix(p netip.Prefix, family int) error {
+	if !p.IsValid() {
+		return ErrInvalidPrefix
+	}
+	if (family == 4 && !p.Addr().Is4()) || (family == 6 && !p.Addr().Is6()) {
+		return ErrWrongFamily
+	}
+	if p.Masked() != p {
+		return ErrHostBits
+	}
+	return nil
+}
+
+// parsePrefix parses an IP prefix of the given family (4 or 6, or 0 for either), without host bits
+// set.
+func parsePrefix(s string, family int) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(strings.TrimSpace(s))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%w: %w", ErrInvalidPrefix, err)
+	}
+	if err := checkPrefix(p, family); err != nil {
+		return netip.Prefix{}, err
+	}
+	return p, nil
+}
+
+// IPv4Prefix is an IPv4 prefix, as used by route objects. The zero value is an invalid prefix,
+// which represents a missing value.
+type IPv4Prefix struct {
+	netip.Prefix
+}
+
+// ParseIPv4Prefix parses an IPv4 prefix, e.g. 192.0.2.0/24. IPv6 prefixes and prefixes with host
+// bits set are rejected.
+func ParseIPv4Prefix(s string) (IPv4Prefix, error) {
+	p, err := parsePrefix(s, 4)
+	if err != nil {
+		return IPv4Prefix{}, err
+	}
+	return IPv4Prefix{p}, nil
+}
+
+// MustParseIPv4Prefix calls rpsl.ParseIPv4Prefix(s) and panics on error. It is intended for use in
+// tests with hard-coded strings.
+func MustParseIPv4Prefix(s string) IPv4Prefix {
+	p, err := ParseIPv4Prefix(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String represents the prefix in RPSL format, e.g. 192.0.2.0/24. An invalid prefix is
+// represented as an empty string.
+func (p IPv4Prefix) String() string {
+	if !p.IsValid() {
+		return ""
+	}
+	return p.Prefix.String()
+}
+
+// MarshalBinary encodes the prefix in RPSL format.
+func (p IPv4Prefix) MarshalBinary() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an IPv4Prefix type.
+func (p IPv4Prefix) UnmarshalBinary(b []byte) (IPv4Prefix, error) {
+	return ParseIPv4Prefix(string(b))
+}
+
+// UnmarshalText parses a text prefix, e.g. from JSON, replacing the method of the embedded
+// netip.Prefix so that the prefix is checked in the same way as by rpsl.ParseIPv4Prefix. An empty
+// value is the zero prefix.
+func (p *IPv4Prefix) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*p = IPv4Prefix{}
+		return nil
+	}
+	parsed, err := ParseIPv4Prefix(string(b))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// IPv6Prefix is an IPv6 prefix, as used by route6 objects. The zero value is an invalid prefix,
+// which represents a missing value.
+type IPv6Prefix struct {
+	netip.Prefix
+}
+
+// ParseIPv6Prefix parses an IPv6 prefix, e.g. 2001:db8::/32. The prefix is normalized to lowercase.
+// IPv4 prefixes and prefixes with host bits set are rejected.
+func ParseIPv6Prefix(s string) (IPv6Prefix, error) {
+	p, err := parsePrefix(s, 6)
+	if err != nil {
+		return IPv6Prefix{}, err
+	}
+	return IPv6Prefix{p}, nil
+}
+
+// MustParseIPv6Prefix calls rpsl.ParseIPv6Prefix(s) and panics on error. It is intended for use in
+// tests with hard-coded strings.
+func MustParseIPv6Prefix(s string) IPv6Prefix {
+	p, err := ParseIPv6Prefix(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String represents the prefix in RPSL format, e.g. 2001:db8::/32. An invalid prefix is
+// represented as an empty string.
+func (p IPv6Prefix) String() string {
+	if !p.IsValid() {
+		return ""
+	}
+	return p.Prefix.String()
+}
+
+// MarshalBinary encodes the prefix in RPSL format.
+func (p IPv6Prefix) MarshalBinary() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an IPv6Prefix type.
+func (p IPv6Prefix) UnmarshalBinary(b []byte) (IPv6Prefix, error) {
+	return ParseIPv6Prefix(string(b))
+}
+
+// UnmarshalText parses a text prefix, e.g. from JSON, replacing the method of the embedded
+// netip.Prefix so that the prefix is checked in the same way as by rpsl.ParseIPv6Prefix. An empty
+// value is the zero prefix.
+func (p *IPv6Prefix) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*p = IPv6Prefix{}
+		return nil
+	}
+	parsed, err := ParseIPv6Prefix(string(b))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
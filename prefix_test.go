@@ -0,0 +1,136 @@
+package rpsl_test
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_IPv4Prefix(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		t.Parallel()
+		p, err := rpsl.ParseIPv4Prefix(" 192.0.2.0/24 ")
+		require.NoError(t, err)
+		assert.Equal(t, "192.0.2.0/24", p.String())
+		assert.Equal(t, 24, p.Bits())
+	})
+	t.Run("parse errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.ParseIPv4Prefix("192.0.2.0")
+		assert.ErrorIs(t, err, rpsl.ErrInvalidPrefix)
+		_, err = rpsl.ParseIPv4Prefix("2001:db8::/32")
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+		_, err = rpsl.ParseIPv4Prefix("192.0.2.1/24")
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+	t.Run("must parse", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "192.0.2.0/24", rpsl.MustParseIPv4Prefix("192.0.2.0/24").String())
+		assert.Panics(t, func() { rpsl.MustParseIPv4Prefix("192.0.2.1/24") })
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		var p rpsl.IPv4Prefix
+		assert.Equal(t, "", p.String())
+	})
+	t.Run("marshal", func(t *testing.T) {
+		t.Parallel()
+		b, err := rpsl.MustParseIPv4Prefix("192.0.2.0/24").MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("192.0.2.0/24"), b)
+	})
+	t.Run("unmarshal", func(t *testing.T) {
+		t.Parallel()
+		var p rpsl.IPv4Prefix
+		r, err := p.UnmarshalBinary([]byte("198.51.100.0/24"))
+		require.NoError(t, err)
+		assert.Equal(t, netip.MustParsePrefix("198.51.100.0/24"), r.Prefix)
+	})
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		var v struct{ Prefix rpsl.IPv4Prefix }
+		require.NoError(t, json.Unmarshal([]byte(`{"Prefix":"192.0.2.0/24"}`), &v))
+		assert.Equal(t, rpsl.MustParseIPv4Prefix("192.0.2.0/24"), v.Prefix)
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Prefix":"192.0.2.0/24"}`, string(b))
+		require.NoError(t, json.Unmarshal([]byte(`{"Prefix":""}`), &v))
+		assert.Equal(t, rpsl.IPv4Prefix{}, v.Prefix)
+		err = json.Unmarshal([]byte(`{"Prefix":"2001:db8::/32"}`), &v)
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+		err = json.Unmarshal([]byte(`{"Prefix":"192.0.2.1/24"}`), &v)
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+	t.Run("decode wrong family", func(t *testing.T) {
+		t.Parallel()
+		var r rpsl.Route
+		err := rpsl.UnmarshalBinary([]byte("route: 2001:db8::/32\norigin: AS65000"), &r)
+		var synErr *rpsl.SyntaxError
+		require.ErrorAs(t, err, &synErr)
+		assert.Equal(t, "route", synErr.Attribute)
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+	})
+}
+
+func Test_IPv6Prefix(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		t.Parallel()
+		p, err := rpsl.ParseIPv6Prefix("2001:DB8:AB::/48")
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8:ab::/48", p.String())
+	})
+	t.Run("parse errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.ParseIPv6Prefix("2001:db8::/129")
+		assert.ErrorIs(t, err, rpsl.ErrInvalidPrefix)
+		_, err = rpsl.ParseIPv6Prefix("192.0.2.0/24")
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+		_, err = rpsl.ParseIPv6Prefix("2001:db8::1/32")
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+	t.Run("must parse", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "2001:db8::/32", rpsl.MustParseIPv6Prefix("2001:db8::/32").String())
+		assert.Panics(t, func() { rpsl.MustParseIPv6Prefix("192.0.2.0/24") })
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		var p rpsl.IPv6Prefix
+		assert.Equal(t, "", p.String())
+	})
+	t.Run("marshal", func(t *testing.T) {
+		t.Parallel()
+		b, err := rpsl.MustParseIPv6Prefix("2001:db8::/32").MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("2001:db8::/32"), b)
+	})
+	t.Run("decode normalized", func(t *testing.T) {
+		t.Parallel()
+		var r rpsl.Route6
+		err := rpsl.UnmarshalBinary([]byte("route6: 2001:DB8::/32\norigin: AS65000"), &r)
+		require.NoError(t, err)
+		b, err := rpsl.MarshalBinary(&r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("route6: 2001:db8::/32\norigin: AS65000"), b)
+	})
+	t.Run("text", func(t *testing.T) {
+		t.Parallel()
+		var p rpsl.IPv6Prefix
+		require.NoError(t, p.UnmarshalText([]byte("2001:DB8::/32")))
+		assert.Equal(t, "2001:db8::/32", p.String())
+		assert.ErrorIs(t, p.UnmarshalText([]byte("192.0.2.0/24")), rpsl.ErrWrongFamily)
+		assert.ErrorIs(t, p.UnmarshalText([]byte("2001:db8::1/32")), rpsl.ErrHostBits)
+		require.NoError(t, p.UnmarshalText(nil))
+		assert.Equal(t, rpsl.IPv6Prefix{}, p)
+	})
+	t.Run("decode host bits", func(t *testing.T) {
+		t.Parallel()
+		var r rpsl.Route6
+		err := rpsl.UnmarshalBinary([]byte("route6: 2001:db8::1/32\norigin: AS65000"), &r)
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+}
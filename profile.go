@@ -0,0 +1,165 @@
+package rpsl
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrGeneratedAttribute describes an attribute that is generated by the registry, and which
+	// must not be submitted, e.g. last-modified for RIPE.
+	ErrGeneratedAttribute = errors.New("attribute is generated by the registry")
+	// ErrWrongSource describes a source attribute that does not match the registry.
+	ErrWrongSource = errors.New("source does not match registry")
+	// ErrUnsupportedClass describes an object class that the registry does not accept.
+	ErrUnsupportedClass = errors.New("class not supported by registry")
+)
+
+// Profile is a set of registry-specific validation rules, applied in addition to the object's own
+// validation (see rpsl.Validate). Built-in profiles are provided for common registries, and custom
+// profiles may be created by populating a Profile directly.
+//
+// Example:
+//
+//	err := rpsl.ARIN.Validate(&route)
+type Profile struct {
+	// Registry name, e.g. ARIN.
+	Name string
+	// Expected value of the source attribute, compared case-insensitively. If empty, any source
+	// is accepted.
+	Source string
+	// Classes accepted by the registry. If empty, any class is accepted.
+	Classes []string
+	// Attributes that must be present, by class name. Attributes listed under "*" must be present
+	// in objects of every class.
+	Mandatory map[string][]string
+	// Attributes generated by the registry, which must not be present in submitted objects.
+	Generated []string
+	// Pattern that every mnt-by value must match. If nil, any valid name is accepted.
+	MntBy *regexp.Regexp
+	// Additional checks, called with the object converted to a generic object.
+	Rules []func(o *Object) error
+}
+
+// Validate validates an RPSL object against the profile, returning all problems found. The
+// argument must be a pointer to an RPSL struct.
+func (p *Profile) Validate(o any) error {
+	var errs []error
+	if err := Validate(o); err != nil {
+		errs = append(errs, err)
+	}
+	obj, err := ToObject(o)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	// Class names are case-insensitive, like attribute names.
+	class := strings.ToLower(obj.Class())
+	if len(p.Classes) > 0 && !slices.Contains(p.Classes, class) {
+		errs = append(errs, &ValidationError{Attribute: class, Value: obj.Key(), Err: ErrUnsupportedClass})
+	}
+	for _, key := range slices.Concat(p.Mandatory["*"], p.Mandatory[class]) {
+		if obj.Get(key) == "" {
+			errs = append(errs, &ValidationError{Attribute: key, Err: ErrMissingAttribute})
+		}
+	}
+	for _, key := range p.Generated {
+		if v := obj.Get(key); v != "" {
+			errs = append(errs, &ValidationError{Attribute: key, Value: v, Err: ErrGeneratedAttribute})
+		}
+	}
+	if source := obj.Get("source"); p.Source != "" && source != "" && !strings.EqualFold(source, p.Source) {
+		errs = append(errs, &ValidationError{Attribute: "source", Value: source, Err: ErrWrongSource})
+	}
+	if p.MntBy != nil {
+		for _, v := range obj.GetAll("mnt-by") {
+			if !p.MntBy.MatchString(v) {
+				errs = append(errs, &ValidationError{Attribute: "mnt-by", Value: v, Err: ErrInvalidName})
+			}
+		}
+	}
+	for _, rule := range p.Rules {
+		if err := rule(obj); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ARIN is the validation profile for the ARIN IRR. ARIN requires the source and mnt-by attributes,
+// and maintainers must be named in the format MNT-OrgID.
+var ARIN = &Profile{
+	Name:   "ARIN",
+	Source: "ARIN",
+	Classes: []string{
+		"aut-num", "as-set", "route", "route6", "route-set", "filter-set", "peering-set", "rtr-set",
+		"inet-rtr", "mntner",
+	},
+	Mandatory: map[string][]string{
+		"*": {"mnt-by", "source"},
+	},
+	Generated: []string{"created", "last-modified"},
+	MntBy:     regexp.MustCompile(`^(?i:MNT)-[A-Za-z0-9-]+$`),
+}
+
+// RIPE is the validation profile for the RIPE database. RIPE requires the source and mnt-by
+// attributes, and generates the created and last-modified attributes.
+var RIPE = &Profile{
+	Name:   "RIPE",
+	Source: "RIPE",
+	Mandatory: map[string][]string{
+		"*":         {"mnt-by", "source"},
+		"aut-num":   {"admin-c", "tech-c"},
+		"as-set":    {"admin-c", "tech-c"},
+		"route-set": {"admin-c", "tech-c"},
+	},
+	Generated: []string{"created", "last-modified"},
+}
+
+// RADb is the validation profile for the RADb IRR. RADb requires the source, mnt-by, and changed
+// attributes.
+var RADb = &Profile{
+	Name:   "RADb",
+	Source: "RADB",
+	Mandatory: map[string][]string{
+		"*": {"mnt-by", "changed", "source"},
+	},
+}
+
+// APNIC is the validation profile for the APNIC whois database. APNIC requires the source and
+// mnt-by attributes, and generates the last-modified attribute.
+var APNIC = &Profile{
+	Name:   "APNIC",
+	Source: "APNIC",
+	Mandatory: map[string][]string{
+		"*":       {"mnt-by", "source"},
+		"aut-num": {"admin-c", "tech-c"},
+	},
+	Generated: []string{"last-modified"},
+}
+
+// AFRINIC is the validation profile for the AFRINIC whois database. AFRINIC requires the source and
+// mnt-by attributes, and generates the created and last-modified attributes.
+var AFRINIC = &Profile{
+	Name:   "AFRINIC",
+	Source: "AFRINIC",
+	Mandatory: map[string][]string{
+		"*":       {"mnt-by", "source"},
+		"aut-num": {"admin-c", "tech-c"},
+	},
+	Generated: []string{"created", "last-modified"},
+}
+
+// LACNIC is the validation profile for the LACNIC IRR. LACNIC requires the source and mnt-by
+// attributes.
+var LACNIC = &Profile{
+	Name:   "LACNIC",
+	Source: "LACNIC",
+	Classes: []string{
+		"aut-num", "as-set", "route", "route6", "route-set", "mntner",
+	},
+	Mandatory: map[string][]string{
+		"*": {"mnt-by", "source"},
+	},
+}
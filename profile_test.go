@@ -0,0 +1,114 @@
+package rpsl_test
+
+import (
+	"errors"
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_Profile(t *testing.T) {
+	t.Run("arin", func(t *testing.T) {
+		t.Parallel()
+		r := &rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000, MntBy: "MNT-ACME", Source: "ARIN"}
+		assert.NoError(t, rpsl.ARIN.Validate(r))
+		r.Source = ""
+		assert.ErrorIs(t, rpsl.ARIN.Validate(r), rpsl.ErrMissingAttribute)
+		r.Source = "RADB"
+		assert.ErrorIs(t, rpsl.ARIN.Validate(r), rpsl.ErrWrongSource)
+		r.Source = "arin"
+		r.MntBy = "ACME-MNT"
+		assert.ErrorIs(t, rpsl.ARIN.Validate(r), rpsl.ErrInvalidName)
+	})
+	t.Run("arin unsupported class", func(t *testing.T) {
+		t.Parallel()
+		o := rpsl.NewObject("person", "John Doe")
+		o.Add("mnt-by", "MNT-ACME")
+		o.Add("source", "ARIN")
+		assert.ErrorIs(t, rpsl.ARIN.Validate(o), rpsl.ErrUnsupportedClass)
+	})
+	t.Run("ripe", func(t *testing.T) {
+		t.Parallel()
+		a := &rpsl.AutNum{
+			AutNum:   65000,
+			ASName:   "ACME",
+			AdminPOC: "ACME-RIPE",
+			TechPOC:  "ACME-RIPE",
+			MntBy:    "ACME-MNT",
+			Source:   "RIPE",
+		}
+		assert.NoError(t, rpsl.RIPE.Validate(a))
+		a.AddExtra("last-modified", "2024-01-02T03:04:05Z")
+		err := rpsl.RIPE.Validate(a)
+		assert.ErrorIs(t, err, rpsl.ErrGeneratedAttribute)
+		var vErr *rpsl.ValidationError
+		assert.True(t, errors.As(err, &vErr))
+		assert.Equal(t, "last-modified", vErr.Attribute)
+		a.Extra = nil
+		a.TechPOC = ""
+		assert.ErrorIs(t, rpsl.RIPE.Validate(a), rpsl.ErrMissingAttribute)
+	})
+	t.Run("radb", func(t *testing.T) {
+		t.Parallel()
+		r := &rpsl.Route6{Route6: rpsl.MustParseIPv6Prefix("2001:db8::/32"), Origin: 65000, MntBy: "MAINT-ACME", Source: "RADB"}
+		assert.ErrorIs(t, rpsl.RADb.Validate(r), rpsl.ErrMissingAttribute)
+		r.AddExtra("changed", "noc@example.com 20240102")
+		assert.NoError(t, rpsl.RADb.Validate(r))
+	})
+	t.Run("mixed case", func(t *testing.T) {
+		t.Parallel()
+		o := &rpsl.Object{Attributes: rpsl.Attributes{
+			{Key: "Route", Value: "192.0.2.0/24"},
+			{Key: "Origin", Value: "AS65000"},
+			{Key: "Mnt-By", Value: "MAINT-ACME"},
+			{Key: "Changed", Value: "noc@example.com 20240102"},
+			{Key: "Source", Value: "RADB"},
+		}}
+		assert.NoError(t, rpsl.RADb.Validate(o))
+	})
+	t.Run("other registries", func(t *testing.T) {
+		for _, p := range []*rpsl.Profile{rpsl.APNIC, rpsl.AFRINIC, rpsl.LACNIC} {
+			t.Run(p.Name, func(t *testing.T) {
+				t.Parallel()
+				r := &rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000, MntBy: "MAINT-ACME", Source: p.Source}
+				assert.NoError(t, p.Validate(r))
+				r.Source = "OTHER"
+				assert.ErrorIs(t, p.Validate(r), rpsl.ErrWrongSource)
+			})
+		}
+	})
+	t.Run("includes object validation", func(t *testing.T) {
+		t.Parallel()
+		r := &rpsl.Route{Route: rpsl.IPv4Prefix{Prefix: netip.MustParsePrefix("192.0.2.1/24")}, Origin: 65000, MntBy: "MNT-ACME", Source: "ARIN"}
+		assert.ErrorIs(t, rpsl.ARIN.Validate(r), rpsl.ErrHostBits)
+	})
+	t.Run("custom", func(t *testing.T) {
+		t.Parallel()
+		errNoRemarks := errors.New("remarks required")
+		p := &rpsl.Profile{
+			Name:    "TEST",
+			Source:  "TEST",
+			Classes: []string{"route"},
+			MntBy:   regexp.MustCompile(`^MAINT-`),
+			Rules: []func(o *rpsl.Object) error{
+				func(o *rpsl.Object) error {
+					if o.Get("remarks") == "" {
+						return errNoRemarks
+					}
+					return nil
+				},
+			},
+		}
+		r := &rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000, MntBy: "MAINT-ACME", Source: "TEST"}
+		assert.ErrorIs(t, p.Validate(r), errNoRemarks)
+		r.AddExtra("remarks", "a remark")
+		assert.NoError(t, p.Validate(r))
+	})
+	t.Run("err non struct", func(t *testing.T) {
+		t.Parallel()
+		assert.Error(t, rpsl.ARIN.Validate("string"))
+	})
+}
@@ -0,0 +1,103 @@
+package rpsl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+var (
+	registryMu sync.RWMutex
+	// registry maps RPSL class names to constructors of their Go types.
+	registry = map[string]func() any{}
+)
+
+func init() {
+	Register[Route]("route")
+	Register[Route6]("route6")
+	Register[AutNum]("aut-num")
+	Register[ASSet]("as-set")
+	Register[RouteSet]("route-set")
+	Register[ASBlock]("as-block")
+	Register[Mntner]("mntner")
+	Register[Person]("person")
+	Register[Role]("role")
+	Register[Inetnum]("inetnum")
+	Register[Inet6num]("inet6num")
+	Register[FilterSet]("filter-set")
+	Register[PeeringSet]("peering-set")
+	Register[RtrSet]("rtr-set")
+	Register[InetRtr]("inet-rtr")
+	Register[KeyCert]("key-cert")
+}
+
+// Register associates an RPSL class name with a Go type, so that objects of the class are decoded
+// to T by rpsl.UnmarshalObject and rpsl.Decoder. T must be an RPSL struct; registering a class
+// again replaces the previous type.
+//
+// Example:
+//
+//	type MyClass struct {
+//		MyClass string          `rpsl:"my-class,mandatory"`
+//		Remarks string          `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+//		Extra   rpsl.Attributes `rpsl:"-"`
+//	}
+//	rpsl.Register[MyClass]("my-class")
+func Register[T any](class string) {
+	if t := reflect.TypeFor[T](); t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("rpsl: Register of non-struct type %s", t))
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[strings.ToLower(class)] = func() any { return new(T) }
+}
+
+// newClass creates a pointer to a new value of the Go type registered for an RPSL class. If no
+// type is registered for the class, a generic *rpsl.Object is returned.
+func newClass(class string) any {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	if newFn, ok := registry[class]; ok {
+		return newFn()
+	}
+	return &Object{}
+}
+
+// UnmarshalObject decodes a byte string of RPSL data to the Go type registered for the object's
+// class, which is determined by the object's first attribute. The returned value is a pointer to an
+// RPSL struct, e.g. *rpsl.Route. If no type is registered for the class, a generic *rpsl.Object is
+// returned.
+//
+// Example:
+//
+//	obj, err := rpsl.UnmarshalObject(b)
+//	switch o := obj.(type) {
+//	case *rpsl.Route:
+//		fmt.Println(o.Route)
+//	case *rpsl.Object:
+//		fmt.Println(o.Class())
+//	}
+func UnmarshalObject(b []byte) (any, error) {
+	return DecodeOptions{}.UnmarshalObject(b)
+}
+
+// UnmarshalObject decodes a byte string of RPSL data to the Go type registered for the object's
+// class, using the options. See rpsl.UnmarshalObject.
+func (opts DecodeOptions) UnmarshalObject(b []byte) (any, error) {
+	return unmarshalObject(b, opts.serialize())
+}
+
+func unmarshalObject(b []byte, opts serialize.Options) (any, error) {
+	class := serialize.Class(b)
+	if class == "" {
+		return nil, ErrEmptyObject
+	}
+	o := newClass(class)
+	if err := serialize.DecodeWithOptions(b, o, opts); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,91 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+type TestClass struct {
+	TestClass string          `rpsl:"test-class,mandatory"`
+	Value     string          `rpsl:"value,omitempty"`
+	Extra     rpsl.Attributes `rpsl:"-"`
+}
+
+func Test_UnmarshalObject(t *testing.T) {
+	cases := []struct {
+		name string
+		b    []byte
+		exp  any
+	}{
+		{"route", []byte("route: 192.0.2.0/24\norigin: AS65000"), &rpsl.Route{}},
+		{"route6", []byte("route6: 2001:db8::/32\norigin: AS65000"), &rpsl.Route6{}},
+		{"aut-num", []byte("aut-num: AS65000\nas-name: ACME"), &rpsl.AutNum{}},
+		{"as-set", []byte("as-set: AS-ACME\nmembers: AS65000"), &rpsl.ASSet{}},
+		{"route-set", []byte("route-set: RS-ACME\nmembers: 192.0.2.0/24"), &rpsl.RouteSet{}},
+		{"as-block", []byte("as-block: AS64496 - AS64511\nsource: RIPE"), &rpsl.ASBlock{}},
+		{"case insensitive", []byte("Route: 192.0.2.0/24\norigin: AS65000"), &rpsl.Route{}},
+		{"mntner", []byte("mntner: MNT-ACME\nauth: MD5-PW # Filtered"), &rpsl.Mntner{}},
+		{"person", []byte("person: John Doe\nnic-hdl: JD1-TEST"), &rpsl.Person{}},
+		{"role", []byte("role: ACME NOC\nnic-hdl: NOC1-TEST"), &rpsl.Role{}},
+		{"inetnum", []byte("inetnum: 192.0.2.0 - 192.0.2.255\nnetname: ACME-NET"), &rpsl.Inetnum{}},
+		{"inet6num", []byte("inet6num: 2001:db8::/32\nnetname: ACME-NET6"), &rpsl.Inet6num{}},
+		{"filter-set", []byte("filter-set: FLTR-BOGONS\nfilter: { 192.0.2.0/24^+ }"), &rpsl.FilterSet{}},
+		{"peering-set", []byte("peering-set: PRNG-TRANSIT\npeering: AS65001"), &rpsl.PeeringSet{}},
+		{"rtr-set", []byte("rtr-set: RTRS-EDGE\nmembers: 192.0.2.1"), &rpsl.RtrSet{}},
+		{"inet-rtr", []byte("inet-rtr: rtr1.example.net\nlocal-as: AS65000"), &rpsl.InetRtr{}},
+		{"key-cert", []byte("key-cert: PGPKEY-3E338801\nmethod: PGP"), &rpsl.KeyCert{}},
+		{"fallback", []byte("organisation: ORG-ACME1-RIPE\nsource: RIPE"), &rpsl.Object{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			obj, err := rpsl.UnmarshalObject(c.b)
+			require.NoError(t, err)
+			assert.IsType(t, c.exp, obj)
+		})
+	}
+	t.Run("values", func(t *testing.T) {
+		t.Parallel()
+		obj, err := rpsl.UnmarshalObject([]byte("route: 192.0.2.0/24\norigin: AS65000"))
+		require.NoError(t, err)
+		assert.Equal(t, &rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000}, obj)
+	})
+	t.Run("case insensitive values", func(t *testing.T) {
+		t.Parallel()
+		obj, err := rpsl.UnmarshalObject([]byte("Route: 192.0.2.0/24\nORIGIN: AS65000"))
+		require.NoError(t, err)
+		assert.Equal(t, &rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000}, obj)
+	})
+	t.Run("err empty", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.UnmarshalObject([]byte("# only a comment\n"))
+		assert.ErrorIs(t, err, rpsl.ErrEmptyObject)
+	})
+	t.Run("err decode", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.UnmarshalObject([]byte("aut-num: ASX"))
+		assert.ErrorContains(t, err, "aut-num")
+	})
+}
+
+func Test_Register(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		rpsl.Register[TestClass]("Test-Class")
+		obj, err := rpsl.UnmarshalObject([]byte("test-class: TEST\nvalue: 1\nother: 2"))
+		require.NoError(t, err)
+		exp := &TestClass{
+			TestClass: "TEST",
+			Value:     "1",
+			Extra:     rpsl.Attributes{{Key: "other", Value: "2", After: &rpsl.Anchor{Key: "value"}}},
+		}
+		assert.Equal(t, exp, obj)
+	})
+	t.Run("non-struct", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { rpsl.Register[string]("test-string") })
+	})
+}
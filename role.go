@@ -0,0 +1,73 @@
+package rpsl
+
+import "errors"
+
+// Role is an RPSL 'role class' object. A role object describes the contact information of a
+// group of people, such as a NOC, who are referred to by the role's NIC handle in the admin-c and
+// tech-c attributes of other objects.
+type Role struct {
+	// Name of the role, e.g. ACME Network Operations.
+	//    *Required
+	Role string `rpsl:"role,mandatory"`
+	// Postal address, one line per attribute.
+	//    *Required
+	Address string `rpsl:"address,mandatory,multiple" as:"multiline"`
+	// Telephone numbers in international format, e.g. +1 555 0100. Multiple numbers are separated by
+	// newlines.
+	Phone string `rpsl:"phone,omitempty,multiple" as:"multiline"`
+	// Fax numbers in international format. Multiple numbers are separated by newlines.
+	FaxNo string `rpsl:"fax-no,omitempty,multiple" as:"multiline"`
+	// E-mail addresses. Multiple addresses are separated by newlines.
+	//    *Required
+	Email string `rpsl:"e-mail,mandatory,multiple" as:"multiline"`
+	// Contact information for problems, e.g. hours of operation or an escalation path.
+	Trouble string `rpsl:"trouble,omitempty,multiple" as:"multiline"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// NIC handle of the role, e.g. NOC1-RIPE.
+	//    *Required
+	NicHdl string `rpsl:"nic-hdl,mandatory"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// E-mail addresses notified of updates to the role object. Multiple addresses are separated by
+	// newlines.
+	Notify string `rpsl:"notify,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the role object. Multiple maintainers are separated by
+	// newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the role object.
+func (r *Role) AddExtra(key, value string) {
+	r.Extra.Add(key, value)
+}
+
+// String representation of the role in RPSL format, which is the NIC handle by which other objects
+// refer to it. E.g. NOC1-RIPE.
+func (r *Role) String() string {
+	return r.NicHdl
+}
+
+// Validate validates the role object, returning all problems found. The nic-hdl must be a valid
+// NIC handle, phone and fax-no values must be telephone numbers in international format, and
+// e-mail and notify values must be e-mail addresses.
+func (r *Role) Validate() error {
+	errs := validateMandatory(r)
+	if r.NicHdl != "" {
+		if err := validateNicHdl("nic-hdl", r.NicHdl); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validatePhones("phone", r.Phone)...)
+	errs = append(errs, validatePhones("fax-no", r.FaxNo)...)
+	errs = append(errs, validateEmails("e-mail", r.Email)...)
+	errs = append(errs, validateEmails("notify", r.Notify)...)
+	errs = append(errs, validateContacts(r.AdminPOC, r.TechPOC, r.MntBy)...)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,87 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestRole_RPSL(t *testing.T) {
+	t.Parallel()
+	r := rpsl.Role{
+		Role:     "ACME Network Operations",
+		Address:  "123 Name Street",
+		Phone:    "+1 555 0100",
+		Email:    "noc@example.com",
+		Trouble:  "24x7: +1 555 0199",
+		AdminPOC: "JD1-TEST",
+		TechPOC:  "JD1-TEST",
+		NicHdl:   "NOC1-TEST",
+		MntBy:    "MNT-ACME",
+		Source:   "TEST",
+	}
+	exp := []byte(`role: ACME Network Operations
+address: 123 Name Street
+phone: +1 555 0100
+e-mail: noc@example.com
+trouble: 24x7: +1 555 0199
+admin-c: JD1-TEST
+tech-c: JD1-TEST
+nic-hdl: NOC1-TEST
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&r)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "NOC1-TEST", r.String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.Role
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, r, decoded)
+	})
+}
+
+func TestRole_Validate(t *testing.T) {
+	valid := func() rpsl.Role {
+		return rpsl.Role{
+			Role:     "ACME Network Operations",
+			Address:  "123 Name Street",
+			Email:    "noc@example.com",
+			NicHdl:   "NOC1-TEST",
+			AdminPOC: "JD1-TEST",
+		}
+	}
+	cases := []struct {
+		name   string
+		modify func(r *rpsl.Role)
+		err    error
+	}{
+		{"valid", func(r *rpsl.Role) {}, nil},
+		{"missing e-mail", func(r *rpsl.Role) { r.Email = "" }, rpsl.ErrMissingAttribute},
+		{"missing nic-hdl", func(r *rpsl.Role) { r.NicHdl = "" }, rpsl.ErrMissingAttribute},
+		{"invalid nic-hdl", func(r *rpsl.Role) { r.NicHdl = "NOC_1" }, rpsl.ErrInvalidNicHdl},
+		{"invalid e-mail", func(r *rpsl.Role) { r.Email = "noc" }, rpsl.ErrInvalidEmail},
+		{"invalid phone", func(r *rpsl.Role) { r.Phone = "+0 555" }, rpsl.ErrInvalidPhone},
+		{"invalid admin-c", func(r *rpsl.Role) { r.AdminPOC = "JD 1" }, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			r := valid()
+			c.modify(&r)
+			err := r.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
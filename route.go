@@ -1,41 +1,71 @@
 package rpsl
 
+import (
+	"errors"
+	"net/netip"
+)
+
 // Route is an RPSL 'route class' object. Each interAS route (also referred to as an interdomain
 // route) originated by an AS is specified using a route object.
 type Route struct {
-	// IPv4 address prefix. It is assumed that this value is a valid IPv4 prefix; no validation occurs.
+	// IPv4 address prefix, without host bits set.
 	//    *Required
-	Route string `rpsl:"route"`
+	Route IPv4Prefix `rpsl:"route,mandatory"`
 	// The ASN from which the route originates.
 	//    *Required
-	Origin ASN `rpsl:"origin"`
+	Origin ASN `rpsl:"origin,mandatory"`
 	// Description for the route object.
-	Description string `rpsl:"descr,omitempty" as:"multiline"`
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
 	// Admin Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	AdminPOC string `rpsl:"admin-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
 	// Technical Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	TechPOC string `rpsl:"tech-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
 	// Maintainer object, the prefix MNT and the Org ID of the organization that configures
 	// (maintains) the IRR object and manages the resource that is specified in the route object.
-	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP.
-	MntBy string `rpsl:"mnt-by,omitempty"`
-	// Private container for extra attributes
-	Extra map[string]string `rpsl:"-"`
+	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP. Multiple maintainers are
+	// separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
 	// Registry Source. Most registries require this field.
 	Source string `rpsl:"source,omitempty"`
 }
 
+// NewRoute creates a route object for an IPv4 prefix originated by an ASN. An error is returned if
+// the prefix is not an IPv4 prefix, or has host bits set.
+func NewRoute(prefix netip.Prefix, origin ASN) (*Route, error) {
+	if err := checkPrefix(prefix, 4); err != nil {
+		return nil, &ValidationError{Attribute: "route", Value: prefix.String(), Err: err}
+	}
+	return &Route{Route: IPv4Prefix{prefix}, Origin: origin}, nil
+}
+
 // Add extra pre-formatted attributes to the route object.
 func (r *Route) AddExtra(key, value string) {
-	if r.Extra == nil {
-		r.Extra = make(map[string]string)
-	}
-	r.Extra[key] = value
+	r.Extra.Add(key, value)
 }
 
 // String representation of the route in RPSL format. E.g. 192.0.2.0/24.
 func (r *Route) String() string {
-	return r.Route
+	return r.Route.String()
+}
+
+// Validate validates the route object, returning all problems found. The route must be a valid
+// IPv4 prefix without host bits set, and the origin must not be a reserved ASN.
+func (r *Route) Validate() error {
+	errs := validateMandatory(r)
+	if r.Route.IsValid() {
+		if err := checkPrefix(r.Route.Prefix, 4); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "route", Value: r.Route.String(), Err: err})
+		}
+	}
+	if r.Origin != 0 {
+		if err := validateOrigin("origin", r.Origin); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateContacts(r.AdminPOC, r.TechPOC, r.MntBy)...)
+	return errors.Join(errs...)
 }
@@ -1,42 +1,72 @@
 package rpsl
 
+import (
+	"errors"
+	"net/netip"
+)
+
 // Route6 is an RPSL 'route6 class' object. The route6 class is the IPv6 equivalent of the route
 // class. Each interAS route (also referred to as an interdomain route) originated by an AS is
 // specified using a route object.
 type Route6 struct {
-	// IPv6 address prefix. It is assumed that this value is a valid IPv6 prefix; no validation occurs.
+	// IPv6 address prefix, without host bits set.
 	//    *Required
-	Route6 string `rpsl:"route6"`
+	Route6 IPv6Prefix `rpsl:"route6,mandatory"`
 	// The ASN from which the route originates.
 	//    *Required
-	Origin ASN `rpsl:"origin"`
+	Origin ASN `rpsl:"origin,mandatory"`
 	// Description for the route6 object.
-	Description string `rpsl:"descr,omitempty" as:"multiline"`
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
 	// Admin Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	AdminPOC string `rpsl:"admin-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
 	// Technical Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	TechPOC string `rpsl:"tech-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
 	// Maintainer object, the prefix MNT and the Org ID of the organization that configures
 	// (maintains) the IRR object and manages the resource that is specified in the route object.
-	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP.
-	MntBy string `rpsl:"mnt-by,omitempty"`
-	// Private container for extra attributes
-	Extra map[string]string `rpsl:"-"`
+	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP. Multiple maintainers are
+	// separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
 	// Registry Source. Most registries require this field.
 	Source string `rpsl:"source,omitempty"`
 }
 
+// NewRoute6 creates a route6 object for an IPv6 prefix originated by an ASN. An error is returned if
+// the prefix is not an IPv6 prefix, or has host bits set.
+func NewRoute6(prefix netip.Prefix, origin ASN) (*Route6, error) {
+	if err := checkPrefix(prefix, 6); err != nil {
+		return nil, &ValidationError{Attribute: "route6", Value: prefix.String(), Err: err}
+	}
+	return &Route6{Route6: IPv6Prefix{prefix}, Origin: origin}, nil
+}
+
 // Add extra pre-formatted attributes to the route6 object.
 func (r *Route6) AddExtra(key, value string) {
-	if r.Extra == nil {
-		r.Extra = make(map[string]string)
-	}
-	r.Extra[key] = value
+	r.Extra.Add(key, value)
 }
 
 // String representation of the route6 in RPSL format. E.g. 2001:db8::/32.
 func (r *Route6) String() string {
-	return r.Route6
+	return r.Route6.String()
+}
+
+// Validate validates the route6 object, returning all problems found. The route6 must be a valid
+// IPv6 prefix without host bits set, and the origin must not be a reserved ASN.
+func (r *Route6) Validate() error {
+	errs := validateMandatory(r)
+	if r.Route6.IsValid() {
+		if err := checkPrefix(r.Route6.Prefix, 6); err != nil {
+			errs = append(errs, &ValidationError{Attribute: "route6", Value: r.Route6.String(), Err: err})
+		}
+	}
+	if r.Origin != 0 {
+		if err := validateOrigin("origin", r.Origin); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateContacts(r.AdminPOC, r.TechPOC, r.MntBy)...)
+	return errors.Join(errs...)
 }
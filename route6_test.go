@@ -1,6 +1,7 @@
 package rpsl_test
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,7 +12,7 @@ import (
 func TestRoute6_RPSL(t *testing.T) {
 	t.Parallel()
 	r := rpsl.Route6{
-		Route6:      "2001:db8::/32",
+		Route6:      rpsl.MustParseIPv6Prefix("2001:db8::/32"),
 		Origin:      65000,
 		Description: "test",
 		AdminPOC:    "TEST-ADMIN",
@@ -36,7 +37,7 @@ mnt-by: MNT-TEST`)
 		r.Source = "ARIN"
 		r.AddExtra("extra", "value")
 		assert.NotNil(t, r.Extra)
-		assert.Equal(t, "value", r.Extra["extra"])
+		assert.Equal(t, "value", r.Extra.Get("extra"))
 		exp := []byte(`route6: 2001:db8::/32
 origin: AS65000
 descr: test
@@ -70,3 +71,49 @@ source: ARIN`)
 		assert.Equal(t, exp, result)
 	})
 }
+
+func TestRoute6_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		route rpsl.Route6
+		err   error
+	}{
+		{"valid", rpsl.Route6{Route6: rpsl.MustParseIPv6Prefix("2001:db8::/32"), Origin: 65000, AdminPOC: "ADMIN\nADMIN-2"}, nil},
+		{"missing route6", rpsl.Route6{Origin: 65000}, rpsl.ErrMissingAttribute},
+		{"wrong family", rpsl.Route6{Route6: rpsl.IPv6Prefix{Prefix: netip.MustParsePrefix("192.0.2.0/24")}, Origin: 65000}, rpsl.ErrWrongFamily},
+		{"host bits", rpsl.Route6{Route6: rpsl.IPv6Prefix{Prefix: netip.MustParsePrefix("2001:db8::1/32")}, Origin: 65000}, rpsl.ErrHostBits},
+		{"reserved origin", rpsl.Route6{Route6: rpsl.MustParseIPv6Prefix("2001:db8::/32"), Origin: 23456}, rpsl.ErrReservedASN},
+		{"invalid tech-c", rpsl.Route6{Route6: rpsl.MustParseIPv6Prefix("2001:db8::/32"), Origin: 65000, TechPOC: "TECH-"}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.route.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func Test_NewRoute6(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		r, err := rpsl.NewRoute6(netip.MustParsePrefix("2001:db8::/32"), 65000)
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8::/32", r.String())
+		assert.Equal(t, rpsl.ASN(65000), r.Origin)
+	})
+	t.Run("wrong family", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.NewRoute6(netip.MustParsePrefix("192.0.2.0/24"), 65000)
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.NewRoute6(netip.Prefix{}, 65000)
+		assert.ErrorIs(t, err, rpsl.ErrInvalidPrefix)
+	})
+}
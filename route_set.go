@@ -1,22 +1,15 @@
 package rpsl
 
 import (
+	"errors"
 	"net/netip"
-	"regexp"
 	"strings"
 )
 
-var startsWithRSDash = regexp.MustCompile(`^[RaSs]{2}\-.*$`)
-var startsWithRS = regexp.MustCompile(`^[RaSs]{2}.*$`)
-
-// RSName creates an route-set member type, e.g. RS-ACME.
+// RSName creates a route-set name, e.g. RS-ACME or AS65000:RS-CUSTOMERS. See
+// rpsl.NormalizeSetName.
 func RSName(name string) string {
-	if startsWithRSDash.MatchString(name) {
-		name = name[3:]
-	} else if startsWithRS.MatchString(name) {
-		name = name[2:]
-	}
-	return "RS-" + name
+	return NormalizeSetName(name, RouteSetPrefix)
 }
 
 // RSMembers creates a list of route-set members for use by [rpsl.RouteSet].
@@ -28,6 +21,12 @@ func RSMembers(vals ...any) []string {
 			out = append(out, t)
 		case netip.Prefix:
 			out = append(out, strings.ToLower(t.String()))
+		case RSMember:
+			out = append(out, t.String())
+		case []RSMember:
+			for _, m := range t {
+				out = append(out, m.String())
+			}
 		}
 	}
 	return out
@@ -40,44 +39,71 @@ type RouteSet struct {
 	// Name of the route-set. Begins with RS- or with an AS that is managed by the organization
 	// followed by a colon and RS- (for example, AS65536:RS-ARIZ-SE-5).
 	//    *Required
-	RouteSet string `rpsl:"route-set"`
+	RouteSet string `rpsl:"route-set,mandatory"`
 	// Description for the route-set object.
-	Description string `rpsl:"descr,omitempty" as:"multiline"`
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
 	// Admin Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	AdminPOC string `rpsl:"admin-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
 	// Technical Point of Contact handle. For ARIN, this field is the exact POC Handle as shown in
-	// Whois/RDAP for the Org ID.
-	TechPOC string `rpsl:"tech-c,omitempty"`
+	// Whois/RDAP for the Org ID. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
 	// Maintainer object, the prefix MNT and the Org ID of the organization that configures
 	// (maintains) the IRR object and manages the resource that is specified in the route object.
-	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP.
-	MntBy string `rpsl:"mnt-by,omitempty"`
+	// It is in the format MNT-OrgID; for example, MNT-EXAMPLECORP. Multiple maintainers are
+	// separated by newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
 	// Any additional information the creator of the objects wants to provide.
-	Remarks string `rpsl:"remarks,omitempty"`
-	// Members of the set; IPv4 prefixes or other route-set names are accepted.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Members of the set; IPv4 prefixes or other route-set names are accepted, optionally followed
+	// by a range operator, e.g. 192.0.2.0/24^+.
 	//
-	// Use rpsl.RSMembers & rpsl.RSMember functions to ensure proper formatting.
-	Members []string `rpsl:"members,omitempty" as:"comma"`
-	// Members of the set; IPv4 prefixes, IPv6 prefixes, or other route-set names are accepted.
+	// Use rpsl.RSMembers & the rpsl.RSMember type to ensure proper formatting.
+	Members []string `rpsl:"members,omitempty,multiple" as:"comma"`
+	// Members of the set; IPv4 prefixes, IPv6 prefixes, or other route-set names are accepted,
+	// optionally followed by a range operator, e.g. 2001:db8::/32^48.
 	//
-	// Use rpsl.RSMembers & rpsl.RSMember functions to ensure proper formatting.
-	MPMembers []string `rpsl:"mp-members,omitempty" as:"comma"`
-	// Private container for extra attributes
-	Extra map[string]string `rpsl:"-"`
+	// Use rpsl.RSMembers & the rpsl.RSMember type to ensure proper formatting.
+	MPMembers []string `rpsl:"mp-members,omitempty,multiple" as:"comma"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
 	// Registry Source. Most registries require this field.
 	Source string `rpsl:"source,omitempty"`
 }
 
 // Add extra pre-formatted attributes to the route-set object.
 func (rs *RouteSet) AddExtra(key, value string) {
-	if rs.Extra == nil {
-		rs.Extra = map[string]string{key: value}
-	}
-	rs.Extra[key] = value
+	rs.Extra.Add(key, value)
 }
 
-// String representation of the route-set in RPSL format. E.g. RS-ACME.
+// String representation of the route-set in RPSL format. E.g. RS-ACME or AS65000:RS-CUSTOMERS.
 func (rs *RouteSet) String() string {
+	if n, err := ParseSetName(rs.RouteSet, RouteSetPrefix); err == nil {
+		return n.String()
+	}
 	return RSName(rs.RouteSet)
 }
+
+// Validate validates the route-set object, returning all problems found. The route-set name must
+// comply with RFC 2622 section 5; members must be IPv4 prefixes or set names, and mp-members may
+// also be IPv6 prefixes.
+func (rs *RouteSet) Validate() error {
+	errs := validateMandatory(rs)
+	if rs.RouteSet != "" {
+		if err := validateSetName("route-set", rs.RouteSet, "RS-"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, m := range rs.Members {
+		if err := validateRSMember("members", m, 4); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, m := range rs.MPMembers {
+		if err := validateRSMember("mp-members", m, 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateContacts(rs.AdminPOC, rs.TechPOC, rs.MntBy)...)
+	return errors.Join(errs...)
+}
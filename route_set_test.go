@@ -25,10 +25,14 @@ members: 192.0.2.0/24,RS-CORP`)
 	t.Run("string", func(t *testing.T) {
 		assert.Equal(t, "RS-ACME", rs.String())
 	})
+	t.Run("string hierarchical", func(t *testing.T) {
+		hierarchical := rpsl.RouteSet{RouteSet: "AS65000:RS-FOO"}
+		assert.Equal(t, "AS65000:RS-FOO", hierarchical.String())
+	})
 	t.Run("with extra", func(t *testing.T) {
 		rs.AddExtra("extra", "value")
 		assert.NotNil(t, rs.Extra)
-		assert.Equal(t, "value", rs.Extra["extra"])
+		assert.Equal(t, "value", rs.Extra.Get("extra"))
 		exp := []byte(`route-set: RS-ACME
 members: 192.0.2.0/24,RS-CORP
 extra: value`)
@@ -65,15 +69,61 @@ func Test_RSSetName(t *testing.T) {
 	})
 	t.Run("no dash", func(t *testing.T) {
 		t.Parallel()
-		assert.Equal(t, "RS-ACME", rpsl.RSName("RSACME"))
+		assert.Equal(t, "RS-RSACME", rpsl.RSName("RSACME"))
 	})
 	t.Run("no prefix", func(t *testing.T) {
 		t.Parallel()
 		assert.Equal(t, "RS-ACME", rpsl.RSName("ACME"))
 	})
+	t.Run("starts with prefix letters", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "RS-SALES", rpsl.RSName("SALES"))
+	})
+	t.Run("hierarchical", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "AS65000:RS-FOO", rpsl.RSName("AS65000:RS-FOO"))
+	})
 }
 
 func Test_RSMembers(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, []string{"192.0.2.0/24", "RS-SET"}, rpsl.RSMembers(netip.MustParsePrefix("192.0.2.0/24"), "RS-SET"))
+	assert.Equal(t,
+		[]string{"192.0.2.0/24^+", "RS-FOO^26", "2001:db8::/32^48"},
+		rpsl.RSMembers(rpsl.MustParseRSMember("192.0.2.0/24^+"), []rpsl.RSMember{rpsl.MustParseRSMember("RS-FOO^26"), rpsl.MustParseRSMember("2001:db8::/32^48")}),
+	)
+}
+
+func TestRouteSet_Validate(t *testing.T) {
+	cases := []struct {
+		name string
+		rs   rpsl.RouteSet
+		err  error
+	}{
+		{"valid", rpsl.RouteSet{
+			RouteSet:  "AS65000:RS-ACME",
+			Members:   []string{"192.0.2.0/24", "198.51.100.0/24^+", "RS-CORP", "AS65000", "AS-ACME"},
+			MPMembers: []string{"2001:db8::/32^48", "192.0.2.0/24"},
+		}, nil},
+		{"missing route-set", rpsl.RouteSet{Members: []string{"192.0.2.0/24"}}, rpsl.ErrMissingAttribute},
+		{"invalid name", rpsl.RouteSet{RouteSet: "AS-ACME"}, rpsl.ErrInvalidSetName},
+		{"ipv6 member", rpsl.RouteSet{RouteSet: "RS-ACME", Members: []string{"2001:db8::/32"}}, rpsl.ErrWrongFamily},
+		{"host bits", rpsl.RouteSet{RouteSet: "RS-ACME", MPMembers: []string{"2001:db8::1/32"}}, rpsl.ErrHostBits},
+		{"host bits ipv4 mp-member", rpsl.RouteSet{RouteSet: "RS-ACME", MPMembers: []string{"192.0.2.1/24"}}, rpsl.ErrHostBits},
+		{"invalid mp-member", rpsl.RouteSet{RouteSet: "RS-ACME", MPMembers: []string{"2001:db8::/32x"}}, rpsl.ErrInvalidPrefix},
+		{"invalid member", rpsl.RouteSet{RouteSet: "RS-ACME", Members: []string{"ACME"}}, rpsl.ErrInvalidSetName},
+		{"invalid range operator", rpsl.RouteSet{RouteSet: "RS-ACME", Members: []string{"192.0.2.0/24^16"}}, rpsl.ErrInvalidRangeOperator},
+		{"ipv6 member with operator", rpsl.RouteSet{RouteSet: "RS-ACME", Members: []string{"2001:db8::/32^+"}}, rpsl.ErrWrongFamily},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.rs.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
 }
@@ -1,6 +1,7 @@
 package rpsl_test
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,7 +12,7 @@ import (
 func TestRoute_RPSL(t *testing.T) {
 	t.Parallel()
 	r := rpsl.Route{
-		Route:       "192.0.2.0/24",
+		Route:       rpsl.MustParseIPv4Prefix("192.0.2.0/24"),
 		Origin:      65000,
 		Description: "test",
 		AdminPOC:    "TEST-ADMIN",
@@ -36,7 +37,7 @@ mnt-by: MNT-TEST`)
 		r.Source = "ARIN"
 		r.AddExtra("extra", "value")
 		assert.NotNil(t, r.Extra)
-		assert.Equal(t, "value", r.Extra["extra"])
+		assert.Equal(t, "value", r.Extra.Get("extra"))
 		exp := []byte(`route: 192.0.2.0/24
 origin: AS65000
 descr: test
@@ -70,3 +71,50 @@ source: ARIN`)
 		assert.Equal(t, exp, result)
 	})
 }
+
+func TestRoute_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		route rpsl.Route
+		err   error
+	}{
+		{"valid", rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000, MntBy: "MNT-ACME"}, nil},
+		{"missing route", rpsl.Route{Origin: 65000}, rpsl.ErrMissingAttribute},
+		{"missing origin", rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24")}, rpsl.ErrMissingAttribute},
+		{"wrong family", rpsl.Route{Route: rpsl.IPv4Prefix{Prefix: netip.MustParsePrefix("2001:db8::/32")}, Origin: 65000}, rpsl.ErrWrongFamily},
+		{"host bits", rpsl.Route{Route: rpsl.IPv4Prefix{Prefix: netip.MustParsePrefix("192.0.2.1/24")}, Origin: 65000}, rpsl.ErrHostBits},
+		{"reserved origin", rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 4294967295}, rpsl.ErrReservedASN},
+		{"invalid mnt-by", rpsl.Route{Route: rpsl.MustParseIPv4Prefix("192.0.2.0/24"), Origin: 65000, MntBy: "MNT ACME"}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.route.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func Test_NewRoute(t *testing.T) {
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+		r, err := rpsl.NewRoute(netip.MustParsePrefix("192.0.2.0/24"), 65000)
+		require.NoError(t, err)
+		assert.Equal(t, "192.0.2.0/24", r.String())
+		assert.Equal(t, rpsl.ASN(65000), r.Origin)
+	})
+	t.Run("wrong family", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.NewRoute(netip.MustParsePrefix("2001:db8::/32"), 65000)
+		assert.ErrorIs(t, err, rpsl.ErrWrongFamily)
+	})
+	t.Run("host bits", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpsl.NewRoute(netip.MustParsePrefix("192.0.2.1/24"), 65000)
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+}
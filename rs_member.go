@@ -0,0 +1,194 @@
+package rpsl
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// RangeOperatorKind is the kind of an RFC 2622 range operator.
+type RangeOperatorKind uint8
+
+const (
+	// NoRange matches only the prefix itself.
+	NoRange RangeOperatorKind = iota
+	// ExclusiveMoreSpecifics (^-) matches the more specifics of the prefix, excluding the prefix
+	// itself.
+	ExclusiveMoreSpecifics
+	// InclusiveMoreSpecifics (^+) matches the more specifics of the prefix, including the prefix
+	// itself.
+	InclusiveMoreSpecifics
+	// ExactLength (^n) matches the more specifics of the prefix of length n.
+	ExactLength
+	// LengthRange (^n-m) matches the more specifics of the prefix of length n to m.
+	LengthRange
+)
+
+// RangeOperator is an RFC 2622 range operator, e.g. ^+ or ^24-28. Low and High are only used by
+// the ExactLength and LengthRange kinds.
+type RangeOperator struct {
+	Kind RangeOperatorKind
+	Low  int
+	High int
+}
+
+// String represents the range operator in RPSL format, e.g. ^24-28. NoRange is represented as an
+// empty string.
+func (op RangeOperator) String() string {
+	switch op.Kind {
+	case ExclusiveMoreSpecifics:
+		return "^-"
+	case InclusiveMoreSpecifics:
+		return "^+"
+	case ExactLength:
+		return "^" + strconv.Itoa(op.Low)
+	case LengthRange:
+		return "^" + strconv.Itoa(op.Low) + "-" + strconv.Itoa(op.High)
+	}
+	return ""
+}
+
+// lengths returns the range of prefix lengths matched by the operator, when applied to a prefix of
+// the given length within an address family of the given size.
+func (op RangeOperator) lengths(bits, maxBits int) (int, int) {
+	switch op.Kind {
+	case ExclusiveMoreSpecifics:
+		return bits + 1, maxBits
+	case InclusiveMoreSpecifics:
+		return bits, maxBits
+	case ExactLength:
+		return op.Low, op.Low
+	case LengthRange:
+		return op.Low, op.High
+	}
+	return bits, bits
+}
+
+// parseRangeOperator parses a range operator without its leading '^' character.
+func parseRangeOperator(s string) (RangeOperator, error) {
+	switch s {
+	case "-":
+		return RangeOperator{Kind: ExclusiveMoreSpecifics}, nil
+	case "+":
+		return RangeOperator{Kind: InclusiveMoreSpecifics}, nil
+	}
+	low, high, isRange := strings.Cut(s, "-")
+	n, err := strconv.ParseUint(low, 10, 8)
+	if err != nil {
+		return RangeOperator{}, fmt.Errorf("%w: '^%s' could not be parsed", ErrInvalidRangeOperator, s)
+	}
+	if !isRange {
+		return RangeOperator{Kind: ExactLength, Low: int(n), High: int(n)}, nil
+	}
+	m, err := strconv.ParseUint(high, 10, 8)
+	if err != nil {
+		return RangeOperator{}, fmt.Errorf("%w: '^%s' could not be parsed", ErrInvalidRangeOperator, s)
+	}
+	return RangeOperator{Kind: LengthRange, Low: int(n), High: int(m)}, nil
+}
+
+// RSMember is a route-set member: an IP prefix, or the name of a route-set, as-set, or ASN,
+// optionally followed by a range operator, e.g. 192.0.2.0/24^+ or RS-ACME^26.
+type RSMember struct {
+	// IP prefix, if the member is a prefix.
+	Prefix netip.Prefix
+	// Set name or ASN, if the member is not a prefix.
+	Name string
+	// Range operator applied to the member.
+	Operator RangeOperator
+}
+
+// ParseRSMember parses a route-set member, e.g. 192.0.2.0/24^24-28, RS-ACME^-, or AS65000. The
+// range operator's lengths must be within the prefix's more specifics, i.e. not less than the
+// prefix length and not greater than the address length.
+func ParseRSMember(s string) (RSMember, error) {
+	s = strings.TrimSpace(s)
+	value, op, hasOp := strings.Cut(s, "^")
+	var m RSMember
+	if strings.Contains(value, "/") {
+		p, err := parsePrefix(value, 0)
+		if err != nil {
+			return RSMember{}, err
+		}
+		m.Prefix = p
+	} else {
+		if !isSetName(value, "RS-") && !isSetName(value, "AS-") && !isASNName(value) {
+			return RSMember{}, ErrInvalidSetName
+		}
+		m.Name = value
+	}
+	if !hasOp {
+		return m, nil
+	}
+	o, err := parseRangeOperator(op)
+	if err != nil {
+		return RSMember{}, err
+	}
+	m.Operator = o
+	if err := m.checkOperator(); err != nil {
+		return RSMember{}, err
+	}
+	return m, nil
+}
+
+// MustParseRSMember calls rpsl.ParseRSMember(s) and panics on error. It is intended for use in
+// tests with hard-coded strings.
+func MustParseRSMember(s string) RSMember {
+	m, err := ParseRSMember(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// checkOperator checks the range operator's lengths against the member's prefix. As the prefixes
+// of set members are unknown, their lengths are only checked against the IPv6 address length.
+func (m RSMember) checkOperator() error {
+	bits, maxBits := 0, 128
+	if m.Prefix.IsValid() {
+		bits, maxBits = m.Prefix.Bits(), m.Prefix.Addr().BitLen()
+	}
+	low, high := m.Operator.lengths(bits, maxBits)
+	if low < bits || high > maxBits || low > high {
+		return fmt.Errorf("%w: '%s' matches no prefixes of length /%d to /%d", ErrInvalidRangeOperator, m.Operator, bits, maxBits)
+	}
+	return nil
+}
+
+// IsPrefix determines if the member is an IP prefix, rather than a set name or ASN.
+func (m RSMember) IsPrefix() bool {
+	return m.Prefix.IsValid()
+}
+
+// String represents the member in RPSL format, e.g. 192.0.2.0/24^+.
+func (m RSMember) String() string {
+	if m.Prefix.IsValid() {
+		return m.Prefix.String() + m.Operator.String()
+	}
+	return m.Name + m.Operator.String()
+}
+
+// MarshalBinary encodes the member in RPSL format.
+func (m RSMember) MarshalBinary() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalBinary parses a byte string to an RSMember type.
+func (m RSMember) UnmarshalBinary(b []byte) (RSMember, error) {
+	return ParseRSMember(string(b))
+}
+
+// Contains determines if a prefix is matched by a prefix member and its range operator, e.g.
+// 192.0.2.0/24^+ contains 192.0.2.128/25. Set members and ASNs contain no prefixes, as their
+// prefixes are unknown.
+func (m RSMember) Contains(p netip.Prefix) bool {
+	if !m.Prefix.IsValid() || !p.IsValid() || m.Prefix.Addr().Is4() != p.Addr().Is4() {
+		return false
+	}
+	low, high := m.Operator.lengths(m.Prefix.Bits(), m.Prefix.Addr().BitLen())
+	if p.Bits() < m.Prefix.Bits() || p.Bits() < low || p.Bits() > high {
+		return false
+	}
+	return m.Prefix.Contains(p.Masked().Addr())
+}
@@ -0,0 +1,110 @@
+package rpsl_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_ParseRSMember(t *testing.T) {
+	cases := []struct {
+		in  string
+		exp rpsl.RSMember
+	}{
+		{"192.0.2.0/24", rpsl.RSMember{Prefix: netip.MustParsePrefix("192.0.2.0/24")}},
+		{"192.0.2.0/24^-", rpsl.RSMember{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Operator: rpsl.RangeOperator{Kind: rpsl.ExclusiveMoreSpecifics}}},
+		{"192.0.2.0/24^+", rpsl.RSMember{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Operator: rpsl.RangeOperator{Kind: rpsl.InclusiveMoreSpecifics}}},
+		{"192.0.2.0/24^26", rpsl.RSMember{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Operator: rpsl.RangeOperator{Kind: rpsl.ExactLength, Low: 26, High: 26}}},
+		{"192.0.2.0/24^24-28", rpsl.RSMember{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Operator: rpsl.RangeOperator{Kind: rpsl.LengthRange, Low: 24, High: 28}}},
+		{"2001:db8::/32^48", rpsl.RSMember{Prefix: netip.MustParsePrefix("2001:db8::/32"), Operator: rpsl.RangeOperator{Kind: rpsl.ExactLength, Low: 48, High: 48}}},
+		{"RS-FOO^26", rpsl.RSMember{Name: "RS-FOO", Operator: rpsl.RangeOperator{Kind: rpsl.ExactLength, Low: 26, High: 26}}},
+		{"AS65000:RS-FOO^+", rpsl.RSMember{Name: "AS65000:RS-FOO", Operator: rpsl.RangeOperator{Kind: rpsl.InclusiveMoreSpecifics}}},
+		{"AS-ACME", rpsl.RSMember{Name: "AS-ACME"}},
+		{"AS65000^-", rpsl.RSMember{Name: "AS65000", Operator: rpsl.RangeOperator{Kind: rpsl.ExclusiveMoreSpecifics}}},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			m, err := rpsl.ParseRSMember(c.in)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, m)
+			assert.Equal(t, c.in, m.String())
+		})
+	}
+	errCases := []struct {
+		in  string
+		err error
+	}{
+		{"192.0.2.0/24^", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.0/24^x", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.0/24^24-", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.0/24^16", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.0/24^33", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.0/24^28-26", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.0/24^20-26", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.1/32^-", rpsl.ErrInvalidRangeOperator},
+		{"2001:db8::/32^129", rpsl.ErrInvalidRangeOperator},
+		{"RS-FOO^129", rpsl.ErrInvalidRangeOperator},
+		{"192.0.2.1/24", rpsl.ErrHostBits},
+		{"192.0.2.0/33", rpsl.ErrInvalidPrefix},
+		{"ACME^+", rpsl.ErrInvalidSetName},
+	}
+	for _, c := range errCases {
+		t.Run("err "+c.in, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseRSMember(c.in)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+	t.Run("must panics", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { rpsl.MustParseRSMember("192.0.2.0/24^16") })
+	})
+}
+
+func TestRSMember_Contains(t *testing.T) {
+	cases := []struct {
+		member string
+		prefix string
+		exp    bool
+	}{
+		{"192.0.2.0/24", "192.0.2.0/24", true},
+		{"192.0.2.0/24", "192.0.2.0/25", false},
+		{"192.0.2.0/24^-", "192.0.2.0/24", false},
+		{"192.0.2.0/24^-", "192.0.2.128/25", true},
+		{"192.0.2.0/24^-", "192.0.2.1/32", true},
+		{"192.0.2.0/24^+", "192.0.2.0/24", true},
+		{"192.0.2.0/24^+", "192.0.2.64/26", true},
+		{"192.0.2.0/24^+", "192.0.0.0/16", false},
+		{"192.0.2.0/24^+", "198.51.100.0/25", false},
+		{"192.0.2.0/24^26", "192.0.2.64/26", true},
+		{"192.0.2.0/24^26", "192.0.2.0/25", false},
+		{"192.0.2.0/24^25-26", "192.0.2.0/25", true},
+		{"192.0.2.0/24^25-26", "192.0.2.0/27", false},
+		{"192.0.2.0/24^+", "2001:db8::/48", false},
+		{"2001:db8::/32^48", "2001:db8:1::/48", true},
+		{"2001:db8::/32^48", "2001:db9::/48", false},
+		{"RS-FOO^+", "192.0.2.0/24", false},
+	}
+	for _, c := range cases {
+		t.Run(c.member+" "+c.prefix, func(t *testing.T) {
+			t.Parallel()
+			m := rpsl.MustParseRSMember(c.member)
+			assert.Equal(t, c.exp, m.Contains(netip.MustParsePrefix(c.prefix)))
+		})
+	}
+}
+
+func TestRSMember_UnmarshalBinary(t *testing.T) {
+	t.Parallel()
+	var m rpsl.RSMember
+	r, err := m.UnmarshalBinary([]byte("192.0.2.0/24^+"))
+	require.NoError(t, err)
+	assert.True(t, r.IsPrefix())
+	b, err := r.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("192.0.2.0/24^+"), b)
+}
@@ -0,0 +1,91 @@
+package rpsl
+
+import (
+	"errors"
+	"net/netip"
+)
+
+// RtrSet is an RPSL 'rtr-set class' object. An rtr-set defines a set of routers, which is referred
+// to by name in the policies of aut-num objects. See RFC 2622 section 5.5 and RFC 4012 section 2.5.
+type RtrSet struct {
+	// Name of the rtr-set. Begins with RTRS-, and may be hierarchical, e.g. AS65000:RTRS-EDGE.
+	//    *Required
+	RtrSet string `rpsl:"rtr-set,mandatory"`
+	// Description for the rtr-set object.
+	Description string `rpsl:"descr,omitempty,multiple" as:"multiline"`
+	// Members of the set; inet-rtr names, IPv4 addresses, or other rtr-set names are accepted.
+	Members []string `rpsl:"members,omitempty,multiple" as:"comma"`
+	// Members of the set; inet-rtr names, IPv4 addresses, IPv6 addresses, or other rtr-set names
+	// are accepted.
+	MPMembers []string `rpsl:"mp-members,omitempty,multiple" as:"comma"`
+	// MembersByRef is a list of maintainer names or the keyword ANY. If this attribute is used,
+	// the rtr-set also includes routers whose inet-rtr objects are registered by one of these
+	// maintainers and whose member-of attribute refers to the name of this rtr-set.
+	MembersByRef []string `rpsl:"mbrs-by-ref,omitempty,multiple" as:"comma-space"`
+	// Admin Point of Contact handle. Multiple handles are separated by newlines.
+	AdminPOC string `rpsl:"admin-c,omitempty,multiple" as:"multiline"`
+	// Technical Point of Contact handle. Multiple handles are separated by newlines.
+	TechPOC string `rpsl:"tech-c,omitempty,multiple" as:"multiline"`
+	// Maintainer object, which maintains the rtr-set object. Multiple maintainers are separated by
+	// newlines.
+	MntBy string `rpsl:"mnt-by,omitempty,multiple" as:"multiline"`
+	// Any additional information the creator of the objects wants to provide.
+	Remarks string `rpsl:"remarks,omitempty,multiple" as:"multiline"`
+	// Attributes not claimed by any other field, in order.
+	Extra Attributes `rpsl:"-"`
+	// Registry Source. Most registries require this field.
+	Source string `rpsl:"source,omitempty"`
+}
+
+// Add extra pre-formatted attributes to the rtr-set object.
+func (r *RtrSet) AddExtra(key, value string) {
+	r.Extra.Add(key, value)
+}
+
+// String representation of the rtr-set in RPSL format. E.g. RTRS-EDGE or AS65000:RTRS-EDGE.
+func (r *RtrSet) String() string {
+	if n, err := ParseSetName(r.RtrSet, RtrSetPrefix); err == nil {
+		return n.String()
+	}
+	return RtrSetName(r.RtrSet)
+}
+
+// Validate validates the rtr-set object, returning all problems found. The rtr-set name must
+// comply with RFC 2622 section 5; members must be inet-rtr names, IPv4 addresses, or rtr-set
+// names, and mp-members may also be IPv6 addresses.
+func (r *RtrSet) Validate() error {
+	errs := validateMandatory(r)
+	if r.RtrSet != "" {
+		if err := validateSetName("rtr-set", r.RtrSet, RtrSetPrefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, m := range r.Members {
+		if err := validateRtrMember("members", m, 4); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, m := range r.MPMembers {
+		if err := validateRtrMember("mp-members", m, 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, validateMbrsByRef(r.MembersByRef)...)
+	errs = append(errs, validateContacts(r.AdminPOC, r.TechPOC, r.MntBy)...)
+	return errors.Join(errs...)
+}
+
+// validateRtrMember checks that a value is a valid rtr-set member: an IP address of the given
+// family (4, or 0 for either), an rtr-set name, or an inet-rtr name.
+func validateRtrMember(attr, value string, family int) error {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		if family == 4 && !addr.Is4() {
+			return &ValidationError{Attribute: attr, Value: value, Err: ErrWrongFamily}
+		}
+		return nil
+	}
+	if hasSetComponent(value, RtrSetPrefix) {
+		return validateSetName(attr, value, RtrSetPrefix)
+	}
+	return validateDNSName(attr, value)
+}
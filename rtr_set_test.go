@@ -0,0 +1,76 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func TestRtrSet_RPSL(t *testing.T) {
+	t.Parallel()
+	r := rpsl.RtrSet{
+		RtrSet:       "RTRS-EDGE",
+		Members:      []string{"rtr1.example.net", "192.0.2.1", "RTRS-CORE"},
+		MPMembers:    []string{"2001:db8::1"},
+		MembersByRef: []string{"MNT-ACME"},
+		MntBy:        "MNT-ACME",
+		Source:       "TEST",
+	}
+	exp := []byte(`rtr-set: RTRS-EDGE
+members: rtr1.example.net,192.0.2.1,RTRS-CORE
+mp-members: 2001:db8::1
+mbrs-by-ref: MNT-ACME
+mnt-by: MNT-ACME
+source: TEST`)
+	t.Run("base", func(t *testing.T) {
+		result, err := rpsl.MarshalBinary(&r)
+		require.NoError(t, err)
+		assert.Equal(t, exp, result)
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "RTRS-EDGE", r.String())
+		assert.Equal(t, "AS65000:RTRS-EDGE", (&rpsl.RtrSet{RtrSet: "AS65000:RTRS-EDGE"}).String())
+		assert.Equal(t, "RTRS-EDGE", (&rpsl.RtrSet{RtrSet: "EDGE"}).String())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var decoded rpsl.RtrSet
+		err := rpsl.UnmarshalBinary(exp, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, r, decoded)
+	})
+}
+
+func TestRtrSet_Validate(t *testing.T) {
+	cases := []struct {
+		name string
+		r    rpsl.RtrSet
+		err  error
+	}{
+		{"valid", rpsl.RtrSet{
+			RtrSet:       "AS65000:RTRS-EDGE",
+			Members:      []string{"rtr1.example.net", "192.0.2.1", "RTRS-CORE", "AS65000:RTRS-CORE"},
+			MPMembers:    []string{"2001:db8::1", "192.0.2.1", "rtr1.example.net."},
+			MembersByRef: []string{"ANY"},
+		}, nil},
+		{"missing rtr-set", rpsl.RtrSet{Members: []string{"192.0.2.1"}}, rpsl.ErrMissingAttribute},
+		{"invalid name", rpsl.RtrSet{RtrSet: "RS-EDGE"}, rpsl.ErrInvalidSetName},
+		{"ipv6 member", rpsl.RtrSet{RtrSet: "RTRS-EDGE", Members: []string{"2001:db8::1"}}, rpsl.ErrWrongFamily},
+		{"invalid member", rpsl.RtrSet{RtrSet: "RTRS-EDGE", Members: []string{"rtr1"}}, rpsl.ErrInvalidDNSName},
+		{"invalid member set", rpsl.RtrSet{RtrSet: "RTRS-EDGE", MPMembers: []string{"RTRS-CORE_"}}, rpsl.ErrInvalidSetName},
+		{"invalid mp-member", rpsl.RtrSet{RtrSet: "RTRS-EDGE", MPMembers: []string{"rtr_1.example.net"}}, rpsl.ErrInvalidDNSName},
+		{"invalid mbrs-by-ref", rpsl.RtrSet{RtrSet: "RTRS-EDGE", MembersByRef: []string{"MNT ACME"}}, rpsl.ErrInvalidName},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			err := c.r.Validate()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package rpsl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Set class prefixes, per RFC 2622 section 5 and RFC 4012.
+const (
+	ASSetPrefix      = "AS-"
+	RouteSetPrefix   = "RS-"
+	FilterSetPrefix  = "FLTR-"
+	RtrSetPrefix     = "RTRS-"
+	PeeringSetPrefix = "PRNG-"
+)
+
+// SetName is the name of a set object, per RFC 2622 section 5. Set names may be hierarchical, in
+// which case they consist of colon-separated components, e.g. AS65000:AS-CUSTOMERS. Each component
+// is an ASN or a name beginning with the set class's prefix, and at least one component must be
+// the latter.
+type SetName struct {
+	// Class prefix of the set, e.g. AS- or RS-.
+	Prefix string
+	// Components of the name, in order, e.g. [AS65000 AS-CUSTOMERS].
+	Components []string
+}
+
+// ParseSetName parses a set name of the class with the given prefix, e.g. AS- for as-set names or
+// RS- for route-set names.
+func ParseSetName(s, prefix string) (SetName, error) {
+	s = strings.TrimSpace(s)
+	components := strings.Split(s, ":")
+	hasSet := false
+	for _, c := range components {
+		switch {
+		case hasClassPrefix(c, prefix):
+			if !objectName.MatchString(c) || isReserved(c) {
+				return SetName{}, fmt.Errorf("%w: component '%s' of '%s' is not a valid name", ErrInvalidSetName, c, s)
+			}
+			hasSet = true
+		case isASNName(c):
+		default:
+			return SetName{}, fmt.Errorf("%w: component '%s' of '%s' is not an ASN or %s name", ErrInvalidSetName, c, s, prefix)
+		}
+	}
+	if !hasSet {
+		return SetName{}, fmt.Errorf("%w: '%s' has no %s component", ErrInvalidSetName, s, prefix)
+	}
+	return SetName{Prefix: prefix, Components: components}, nil
+}
+
+// MustParseSetName calls rpsl.ParseSetName(s, prefix) and panics on error. It is intended for use
+// in tests with hard-coded strings.
+func MustParseSetName(s, prefix string) SetName {
+	n, err := ParseSetName(s, prefix)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// String represents the set name in RPSL format, e.g. AS65000:AS-CUSTOMERS.
+func (n SetName) String() string {
+	return strings.Join(n.Components, ":")
+}
+
+// IsHierarchical determines if the set name has more than one component.
+func (n SetName) IsHierarchical() bool {
+	return len(n.Components) > 1
+}
+
+// Parent returns the set name without its last component, e.g. AS65000:AS-CUSTOMERS:AS-EU has the
+// parent AS65000:AS-CUSTOMERS. The parent of AS65000:AS-CUSTOMERS is the aut-num AS65000, which is
+// not a set name, so false is returned, as it is for a name with a single component.
+func (n SetName) Parent() (SetName, bool) {
+	if len(n.Components) < 2 {
+		return SetName{}, false
+	}
+	parent := SetName{Prefix: n.Prefix, Components: n.Components[:len(n.Components)-1]}
+	for _, c := range parent.Components {
+		if !isASNName(c) {
+			return parent, true
+		}
+	}
+	return SetName{}, false
+}
+
+// hasClassPrefix determines if a name component begins with a set class prefix, e.g. AS-, in any
+// case.
+func hasClassPrefix(c, prefix string) bool {
+	return len(c) > len(prefix) && strings.EqualFold(c[:len(prefix)], prefix)
+}
+
+// hasSetComponent determines if any component of a possibly hierarchical name begins with a set
+// class prefix.
+func hasSetComponent(name, prefix string) bool {
+	for _, c := range strings.Split(name, ":") {
+		if hasClassPrefix(c, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeSetName normalizes a set name of the class with the given prefix, e.g. AS-. Class
+// prefixes and the AS of ASN components are uppercased, and reserved names such as AS-ANY are
+// uppercased entirely; the case of the rest of the name is preserved.
+//
+// If no component of the name has the class prefix, the prefix is added to the last component,
+// e.g. ACME becomes RS-ACME and AS65000:CUSTOMERS becomes AS65000:AS-CUSTOMERS. ASN components of
+// hierarchical names are left as they are, e.g. AS65000:AS65001. An as-set name that is a single
+// ASN is corrected to the as-set of the ASN, e.g. AS65000 becomes AS-65000. Other prefixes missing
+// their dash are not corrected, as names like RSVP cannot be told apart from them.
+//
+// The result is not validated, so it may not be a valid set name, e.g. AS65000:AS65001, which has
+// no set component. Use rpsl.NormalizeSetNameStrict to reject such names.
+func NormalizeSetName(name, prefix string) string {
+	prefix = strings.ToUpper(prefix)
+	components := strings.Split(strings.TrimSpace(name), ":")
+	hasSet := false
+	for i, c := range components {
+		c = strings.TrimSpace(c)
+		switch {
+		case hasClassPrefix(c, prefix):
+			c = prefix + c[len(prefix):]
+			hasSet = true
+		case isASNName(c):
+			c = "AS" + c[2:]
+		}
+		components[i] = c
+	}
+	last := len(components) - 1
+	switch {
+	case hasSet:
+	case prefix == ASSetPrefix && last == 0 && isASNName(components[0]):
+		components[0] = prefix + components[0][2:]
+	case last > 0 && isASNName(components[last]):
+	default:
+		components[last] = prefix + components[last]
+	}
+	for i, c := range components {
+		if isReserved(c) {
+			components[i] = strings.ToUpper(c)
+		}
+	}
+	return strings.Join(components, ":")
+}
+
+// NormalizeSetNameStrict normalizes a set name as rpsl.NormalizeSetName does, and parses the
+// result, returning an error if it is not a valid set name of the class, e.g. AS65000:AS65001.
+func NormalizeSetNameStrict(name, prefix string) (SetName, error) {
+	return ParseSetName(NormalizeSetName(name, prefix), strings.ToUpper(prefix))
+}
+
+// FilterSetName creates a filter-set name, e.g. FLTR-MARTIANS. See rpsl.NormalizeSetName.
+func FilterSetName(name string) string {
+	return NormalizeSetName(name, FilterSetPrefix)
+}
+
+// RtrSetName creates an rtr-set name, e.g. RTRS-EDGE. See rpsl.NormalizeSetName.
+func RtrSetName(name string) string {
+	return NormalizeSetName(name, RtrSetPrefix)
+}
+
+// PeeringSetName creates a peering-set name, e.g. PRNG-TRANSIT. See rpsl.NormalizeSetName.
+func PeeringSetName(name string) string {
+	return NormalizeSetName(name, PeeringSetPrefix)
+}
@@ -0,0 +1,206 @@
+package rpsl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_ParseSetName(t *testing.T) {
+	cases := []struct {
+		in     string
+		prefix string
+		exp    []string
+	}{
+		{"AS-ACME", "AS-", []string{"AS-ACME"}},
+		{"as-acme", "AS-", []string{"as-acme"}},
+		{"AS65000:AS-CUSTOMERS", "AS-", []string{"AS65000", "AS-CUSTOMERS"}},
+		{"AS-ACME:AS65000", "AS-", []string{"AS-ACME", "AS65000"}},
+		{"AS65000:AS-CUSTOMERS:AS65001", "AS-", []string{"AS65000", "AS-CUSTOMERS", "AS65001"}},
+		{"AS65000:RS-FOO", "RS-", []string{"AS65000", "RS-FOO"}},
+		{"RS-FOO:RS-BAR", "RS-", []string{"RS-FOO", "RS-BAR"}},
+		{"FLTR-MARTIANS", "FLTR-", []string{"FLTR-MARTIANS"}},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			n, err := rpsl.ParseSetName(c.in, c.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, n.Components)
+			assert.Equal(t, c.prefix, n.Prefix)
+			assert.Equal(t, c.in, n.String())
+		})
+	}
+	errCases := []struct {
+		in     string
+		prefix string
+	}{
+		{"", "AS-"},
+		{"ACME", "AS-"},
+		{"AS65000", "AS-"},
+		{"AS65000:AS65001", "AS-"},
+		{"AS-", "AS-"},
+		{"AS-ACME:", "AS-"},
+		{"AS65000:RS-FOO", "AS-"},
+		{"AS-ACME_", "AS-"},
+		{"AS-ANY", "AS-"},
+		{"AS65000:RS-ANY", "RS-"},
+		{"AS65000:ACME", "AS-"},
+	}
+	for _, c := range errCases {
+		t.Run("err "+c.in, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.ParseSetName(c.in, c.prefix)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidSetName)
+		})
+	}
+	t.Run("must panics", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { rpsl.MustParseSetName("ACME", "AS-") })
+	})
+}
+
+func TestSetName_Parent(t *testing.T) {
+	t.Run("hierarchical", func(t *testing.T) {
+		t.Parallel()
+		n := rpsl.MustParseSetName("AS65000:AS-CUSTOMERS:AS-EU", "AS-")
+		assert.True(t, n.IsHierarchical())
+		parent, ok := n.Parent()
+		require.True(t, ok)
+		assert.Equal(t, "AS65000:AS-CUSTOMERS", parent.String())
+		_, ok = parent.Parent()
+		assert.False(t, ok)
+	})
+	t.Run("single", func(t *testing.T) {
+		t.Parallel()
+		n := rpsl.MustParseSetName("AS-ACME", "AS-")
+		assert.False(t, n.IsHierarchical())
+		_, ok := n.Parent()
+		assert.False(t, ok)
+	})
+}
+
+func Test_NormalizeSetName(t *testing.T) {
+	cases := []struct {
+		in     string
+		prefix string
+		exp    string
+	}{
+		// as-set
+		{"AS-ACME", rpsl.ASSetPrefix, "AS-ACME"},
+		{"as-acme", rpsl.ASSetPrefix, "AS-acme"},
+		{"As-Acme", rpsl.ASSetPrefix, "AS-Acme"},
+		{"ACME", rpsl.ASSetPrefix, "AS-ACME"},
+		{" ACME ", rpsl.ASSetPrefix, "AS-ACME"},
+		{"AS65000", rpsl.ASSetPrefix, "AS-65000"},
+		{"as65000", rpsl.ASSetPrefix, "AS-65000"},
+		{"65000", rpsl.ASSetPrefix, "AS-65000"},
+		{"AS-65000", rpsl.ASSetPrefix, "AS-65000"},
+		{"ASTRO", rpsl.ASSetPrefix, "AS-ASTRO"},
+		{"SALES", rpsl.ASSetPrefix, "AS-SALES"},
+		{"SA-ACME", rpsl.ASSetPrefix, "AS-SA-ACME"},
+		{"AS65000:AS-CUST", rpsl.ASSetPrefix, "AS65000:AS-CUST"},
+		{"as65000:as-cust", rpsl.ASSetPrefix, "AS65000:AS-cust"},
+		{"AS65000:CUST", rpsl.ASSetPrefix, "AS65000:AS-CUST"},
+		{"AS-ACME:AS65000", rpsl.ASSetPrefix, "AS-ACME:AS65000"},
+		{"AS65000:AS-CUST:AS65001", rpsl.ASSetPrefix, "AS65000:AS-CUST:AS65001"},
+		{"AS65000:AS65001", rpsl.ASSetPrefix, "AS65000:AS65001"},
+		{"ANY", rpsl.ASSetPrefix, "AS-ANY"},
+		{"as-any", rpsl.ASSetPrefix, "AS-ANY"},
+		{"AS-ANY", rpsl.ASSetPrefix, "AS-ANY"},
+		// route-set
+		{"RS-ACME", rpsl.RouteSetPrefix, "RS-ACME"},
+		{"rs-acme", rpsl.RouteSetPrefix, "RS-acme"},
+		{"RSACME", rpsl.RouteSetPrefix, "RS-RSACME"},
+		{"RSVP", rpsl.RouteSetPrefix, "RS-RSVP"},
+		{"RSTUDIO", rpsl.RouteSetPrefix, "RS-RSTUDIO"},
+		{"ACME", rpsl.RouteSetPrefix, "RS-ACME"},
+		{"SALES", rpsl.RouteSetPrefix, "RS-SALES"},
+		{"SA-ACME", rpsl.RouteSetPrefix, "RS-SA-ACME"},
+		{"AS-ACME", rpsl.RouteSetPrefix, "RS-AS-ACME"},
+		{"AS65000:RS-FOO", rpsl.RouteSetPrefix, "AS65000:RS-FOO"},
+		{"as65000:rs-foo", rpsl.RouteSetPrefix, "AS65000:RS-foo"},
+		{"AS65000:FOO", rpsl.RouteSetPrefix, "AS65000:RS-FOO"},
+		{"RS-FOO:RS-BAR", rpsl.RouteSetPrefix, "RS-FOO:RS-BAR"},
+		{"AS65000:AS65001", rpsl.RouteSetPrefix, "AS65000:AS65001"},
+		{"ANY", rpsl.RouteSetPrefix, "RS-ANY"},
+		{"rs-any", rpsl.RouteSetPrefix, "RS-ANY"},
+		// filter-set
+		{"FLTR-MARTIANS", rpsl.FilterSetPrefix, "FLTR-MARTIANS"},
+		{"fltr-martians", rpsl.FilterSetPrefix, "FLTR-martians"},
+		{"FLTRX", rpsl.FilterSetPrefix, "FLTR-FLTRX"},
+		{"MARTIANS", rpsl.FilterSetPrefix, "FLTR-MARTIANS"},
+		{"AS65000:FLTR-BOGONS", rpsl.FilterSetPrefix, "AS65000:FLTR-BOGONS"},
+		{"AS65000:BOGONS", rpsl.FilterSetPrefix, "AS65000:FLTR-BOGONS"},
+		// rtr-set
+		{"RTRS-EDGE", rpsl.RtrSetPrefix, "RTRS-EDGE"},
+		{"rtrs-edge", rpsl.RtrSetPrefix, "RTRS-edge"},
+		{"RTRSEDGE", rpsl.RtrSetPrefix, "RTRS-RTRSEDGE"},
+		{"EDGE", rpsl.RtrSetPrefix, "RTRS-EDGE"},
+		{"AS65000:RTRS-EDGE", rpsl.RtrSetPrefix, "AS65000:RTRS-EDGE"},
+		// peering-set
+		{"PRNG-TRANSIT", rpsl.PeeringSetPrefix, "PRNG-TRANSIT"},
+		{"prng-transit", rpsl.PeeringSetPrefix, "PRNG-transit"},
+		{"PRNGTRANSIT", rpsl.PeeringSetPrefix, "PRNG-PRNGTRANSIT"},
+		{"TRANSIT", rpsl.PeeringSetPrefix, "PRNG-TRANSIT"},
+		{"AS65000:PRNG-TRANSIT", rpsl.PeeringSetPrefix, "AS65000:PRNG-TRANSIT"},
+		// lowercase prefix argument
+		{"acme", "as-", "AS-acme"},
+	}
+	for _, c := range cases {
+		t.Run(c.prefix+c.in, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.exp, rpsl.NormalizeSetName(c.in, c.prefix))
+		})
+	}
+}
+
+func Test_NormalizeSetNameStrict(t *testing.T) {
+	cases := []struct {
+		in     string
+		prefix string
+		exp    string
+	}{
+		{"ACME", rpsl.ASSetPrefix, "AS-ACME"},
+		{"AS65000", rpsl.ASSetPrefix, "AS-65000"},
+		{"as65000:cust", rpsl.ASSetPrefix, "AS65000:AS-cust"},
+		{"RSACME", rpsl.RouteSetPrefix, "RS-RSACME"},
+		{"acme", "rs-", "RS-acme"},
+	}
+	for _, c := range cases {
+		t.Run(c.prefix+c.in, func(t *testing.T) {
+			t.Parallel()
+			n, err := rpsl.NormalizeSetNameStrict(c.in, c.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, c.exp, n.String())
+		})
+	}
+	errCases := []struct {
+		in     string
+		prefix string
+	}{
+		{"AS65000:AS65001", rpsl.ASSetPrefix},
+		{"AS65000:AS65001", rpsl.RouteSetPrefix},
+		{"ACME!", rpsl.ASSetPrefix},
+		{"", rpsl.RouteSetPrefix},
+		{"AS65000::ACME", rpsl.FilterSetPrefix},
+	}
+	for _, c := range errCases {
+		t.Run("err "+c.prefix+c.in, func(t *testing.T) {
+			t.Parallel()
+			_, err := rpsl.NormalizeSetNameStrict(c.in, c.prefix)
+			assert.ErrorIs(t, err, rpsl.ErrInvalidSetName)
+		})
+	}
+}
+
+func Test_SetNameHelpers(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "AS-ACME", rpsl.ASSetName("ACME"))
+	assert.Equal(t, "RS-ACME", rpsl.RSName("ACME"))
+	assert.Equal(t, "FLTR-ACME", rpsl.FilterSetName("ACME"))
+	assert.Equal(t, "RTRS-ACME", rpsl.RtrSetName("ACME"))
+	assert.Equal(t, "PRNG-ACME", rpsl.PeeringSetName("ACME"))
+}
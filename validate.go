@@ -0,0 +1,357 @@
+package rpsl
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mdl.wtf/rpsl/internal/serialize"
+)
+
+var (
+	// ErrInvalidPrefix describes a value that cannot be parsed as an IP prefix.
+	ErrInvalidPrefix = errors.New("invalid prefix")
+	// ErrWrongFamily describes an IP prefix of the wrong address family, e.g. an IPv6 prefix in a
+	// route object.
+	ErrWrongFamily = errors.New("wrong address family")
+	// ErrHostBits describes an IP prefix with host bits set, e.g. 192.0.2.1/24.
+	ErrHostBits = errors.New("prefix has host bits set")
+	// ErrInvalidASN describes a value that cannot be parsed as an ASN.
+	ErrInvalidASN = errors.New("invalid ASN")
+	// ErrInvalidRange describes a range whose bounds cannot be parsed, or whose first value is
+	// greater than its last, e.g. AS64511 - AS64496.
+	ErrInvalidRange = errors.New("invalid range")
+	// ErrInvalidRangeOperator describes an RFC 2622 range operator that cannot be parsed, or that
+	// matches no prefixes, e.g. 192.0.2.0/24^16.
+	ErrInvalidRangeOperator = errors.New("invalid range operator")
+	// ErrInvalidAuth describes a mntner auth value with an unknown scheme or a malformed
+	// credential, e.g. MD5-PW with a value that is not an MD5-crypt hash.
+	ErrInvalidAuth = errors.New("invalid auth")
+	// ErrInvalidNicHdl describes a NIC handle that does not comply with RFC 2622 section 3.2, e.g.
+	// a person or role nic-hdl value.
+	ErrInvalidNicHdl = errors.New("invalid NIC handle")
+	// ErrInvalidEmail describes a value that is not an RFC 5322 e-mail address.
+	ErrInvalidEmail = errors.New("invalid e-mail address")
+	// ErrInvalidPhone describes a value that is not a telephone number in international format,
+	// e.g. +1 555 0100 ext. 42.
+	ErrInvalidPhone = errors.New("invalid phone number")
+	// ErrInvalidCountry describes a value that is not an ISO 3166-1 alpha-2 country code, e.g. a
+	// country value of an inetnum object.
+	ErrInvalidCountry = errors.New("invalid country code")
+	// ErrInvalidFilter describes a policy filter expression with unbalanced brackets, e.g. a
+	// filter value of a filter-set object.
+	ErrInvalidFilter = errors.New("invalid filter")
+	// ErrInvalidPeering describes a peering expression that does not begin with an AS expression or
+	// a peering-set name, e.g. a peering value of a peering-set object.
+	ErrInvalidPeering = errors.New("invalid peering")
+	// ErrInvalidDNSName describes a value that is not a fully qualified DNS name, e.g. an inet-rtr
+	// name.
+	ErrInvalidDNSName = errors.New("invalid DNS name")
+	// ErrInvalidInterface describes an inet-rtr ifaddr or interface value that cannot be parsed,
+	// e.g. 192.0.2.1 masklen 33.
+	ErrInvalidInterface = errors.New("invalid interface")
+	// ErrInvalidPeer describes an inet-rtr peer or mp-peer value that cannot be parsed, e.g. one
+	// with an unknown protocol.
+	ErrInvalidPeer = errors.New("invalid peer")
+	// ErrInvalidKeyCert describes a key-cert object whose certif is not an ASCII-armored OpenPGP
+	// public key, or whose name, method, owner, or fingerpr does not match its key.
+	ErrInvalidKeyCert = errors.New("invalid key-cert")
+	// ErrReservedASN describes an ASN that may not be used as an origin or aut-num, e.g. AS0.
+	ErrReservedASN = errors.New("reserved ASN")
+	// ErrInvalidSetName describes a set name that does not comply with RFC 2622 section 5.
+	ErrInvalidSetName = errors.New("invalid set name")
+	// ErrInvalidName describes an object name or handle that does not comply with RFC 2622
+	// section 2, e.g. a mnt-by or admin-c value.
+	ErrInvalidName = errors.New("invalid name")
+)
+
+// ValidationError describes an attribute that failed validation.
+type ValidationError struct {
+	// Attribute name, e.g. origin.
+	Attribute string
+	// Attribute value. Empty if the attribute is missing.
+	Value string
+	// Underlying cause, e.g. rpsl.ErrHostBits.
+	Err error
+}
+
+// Error returns a string representation of the error.
+func (e *ValidationError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("rpsl: %s: %v", e.Attribute, e.Err)
+	}
+	return fmt.Sprintf("rpsl: %s: invalid value '%s': %v", e.Attribute, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying cause of the error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Validator is implemented by RPSL structs that can validate themselves.
+type Validator interface {
+	Validate() error
+}
+
+// Validate validates an RPSL object prior to submission to a registry. The argument must be a
+// pointer to an RPSL struct. If the object implements rpsl.Validator, its Validate method is
+// called; otherwise, only the presence of mandatory attributes is checked.
+//
+// All problems are reported together; use errors.As with *rpsl.ValidationError, or errors.Is with
+// e.g. rpsl.ErrHostBits, to inspect them.
+func Validate(o any) error {
+	if v, ok := o.(Validator); ok {
+		return v.Validate()
+	}
+	rv := reflect.ValueOf(o)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("rpsl: cannot validate %T; value must be a pointer to a struct", o)
+	}
+	return errors.Join(validateMandatory(o)...)
+}
+
+// validateMandatory checks that all mandatory attributes of an RPSL struct are non-zero.
+func validateMandatory(o any) []error {
+	rv := reflect.ValueOf(o).Elem()
+	var errs []error
+	for _, spec := range serialize.Specs(rv.Type()) {
+		if spec.Mandatory && rv.FieldByName(spec.Field).IsZero() {
+			errs = append(errs, &ValidationError{Attribute: spec.Key, Err: ErrMissingAttribute})
+		}
+	}
+	return errs
+}
+
+// validatePrefix checks that a value is an IP prefix of the given family (4 or 6, or 0 for
+// either), without host bits set.
+func validatePrefix(attr, value string, family int) error {
+	if _, err := parsePrefix(value, family); err != nil {
+		return &ValidationError{Attribute: attr, Value: value, Err: err}
+	}
+	return nil
+}
+
+// validateOrigin checks that an ASN may be used as an origin or aut-num, i.e. that it is not
+// reserved. See ASN.IsReserved.
+func validateOrigin(attr string, a ASN) error {
+	if a.IsReserved() {
+		return &ValidationError{Attribute: attr, Value: a.String(), Err: ErrReservedASN}
+	}
+	return nil
+}
+
+// objectName matches an RPSL object name, per RFC 2622 section 2: letters, digits, '_', and '-',
+// beginning with a letter and ending with a letter or digit.
+var objectName = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_-]*[A-Za-z0-9])?$`)
+
+// reservedWords may not be used as object names, per RFC 2622 section 2.
+var reservedWords = []string{
+	"any", "as-any", "rs-any", "peeras", "and", "or", "not", "atomic", "from", "to", "at",
+	"action", "accept", "announce", "except", "refine", "networks", "into", "inbound", "outbound",
+}
+
+// isReserved determines if a name is an RPSL reserved word.
+func isReserved(name string) bool {
+	for _, w := range reservedWords {
+		if strings.EqualFold(name, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitLines splits newline-separated values, e.g. of a multiline attribute, trimming whitespace
+// and skipping empty values.
+func splitLines(values string) []string {
+	var out []string
+	for _, v := range strings.Split(values, "\n") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// validateNames checks that each newline-separated value is a valid RPSL object name, e.g. a
+// mnt-by or admin-c value.
+func validateNames(attr, values string) []error {
+	var errs []error
+	for _, v := range splitLines(values) {
+		if !objectName.MatchString(v) || isReserved(v) {
+			errs = append(errs, &ValidationError{Attribute: attr, Value: v, Err: ErrInvalidName})
+		}
+	}
+	return errs
+}
+
+// isASNName determines if a value is an ASN in RPSL format, e.g. AS65000.
+func isASNName(value string) bool {
+	if len(value) < 3 || !strings.EqualFold(value[:2], "AS") {
+		return false
+	}
+	_, err := strconv.ParseUint(value[2:], 10, 32)
+	return err == nil
+}
+
+// isSetName determines if a value is a set name with the given class prefix (e.g. AS-); see
+// rpsl.ParseSetName.
+func isSetName(value, prefix string) bool {
+	_, err := ParseSetName(value, prefix)
+	return err == nil
+}
+
+// validateSetName checks that a value is a set name with the given class prefix (e.g. AS-).
+func validateSetName(attr, value, prefix string) error {
+	if !isSetName(value, prefix) {
+		return &ValidationError{Attribute: attr, Value: value, Err: ErrInvalidSetName}
+	}
+	return nil
+}
+
+// nicHdl matches a NIC handle: up to ten letters, up to six digits, and an optional suffix naming
+// the registry or organization, e.g. JD1-RIPE, DOEJO-ARIN, or AUTO-1 for registries that assign
+// handles automatically.
+var nicHdl = regexp.MustCompile(`^(?i:AUTO-\d+[A-Z]*|[A-Z]{2,10}\d{0,6}(-[A-Z][A-Z0-9_]{1,9})?)$`)
+
+// validateNicHdl checks that a value is a NIC handle.
+func validateNicHdl(attr, value string) error {
+	if !nicHdl.MatchString(value) {
+		return &ValidationError{Attribute: attr, Value: value, Err: ErrInvalidNicHdl}
+	}
+	return nil
+}
+
+// validateEmails checks that each newline-separated value is a bare e-mail address, without a
+// display name, e.g. noc@example.com.
+func validateEmails(attr, values string) []error {
+	var errs []error
+	for _, v := range splitLines(values) {
+		if addr, err := mail.ParseAddress(v); err != nil || addr.Address != v || !strings.Contains(v, "@") {
+			errs = append(errs, &ValidationError{Attribute: attr, Value: v, Err: ErrInvalidEmail})
+		}
+	}
+	return errs
+}
+
+// phone matches a telephone number in international format, per RFC 2622 section 3.2: a '+',
+// country code, and number, optionally followed by an extension, e.g. +1 555 0100 ext. 42.
+var phone = regexp.MustCompile(`^\+[1-9][0-9 .()-]*[0-9]( ext\. ?[0-9]+)?$`)
+
+// validatePhones checks that each newline-separated value is a telephone number.
+func validatePhones(attr, values string) []error {
+	var errs []error
+	for _, v := range splitLines(values) {
+		if !phone.MatchString(v) {
+			errs = append(errs, &ValidationError{Attribute: attr, Value: v, Err: ErrInvalidPhone})
+		}
+	}
+	return errs
+}
+
+// countryCode matches an ISO 3166-1 alpha-2 country code, e.g. US. EU and ZZ, which registries
+// also accept, are matched by the same pattern.
+var countryCode = regexp.MustCompile(`^[A-Za-z]{2}$`)
+
+// validateCountries checks that each newline-separated value is a country code.
+func validateCountries(attr, values string) []error {
+	var errs []error
+	for _, v := range splitLines(values) {
+		if !countryCode.MatchString(v) {
+			errs = append(errs, &ValidationError{Attribute: attr, Value: v, Err: ErrInvalidCountry})
+		}
+	}
+	return errs
+}
+
+// balanced determines if the parentheses, braces, and AS path brackets of a policy expression are
+// balanced, e.g. { 192.0.2.0/24^+ } AND <^AS65000+$>.
+func balanced(value string) bool {
+	closers := map[rune]rune{')': '(', '}': '{', '>': '<'}
+	var stack []rune
+	for _, c := range value {
+		switch c {
+		case '(', '{', '<':
+			stack = append(stack, c)
+		case ')', '}', '>':
+			if len(stack) == 0 || stack[len(stack)-1] != closers[c] {
+				return false
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return len(stack) == 0
+}
+
+// validateFilter checks that a policy filter expression is non-empty, with balanced brackets. The
+// expression is not otherwise parsed.
+func validateFilter(attr, value string) error {
+	if strings.TrimSpace(value) == "" || !balanced(value) {
+		return &ValidationError{Attribute: attr, Value: value, Err: ErrInvalidFilter}
+	}
+	return nil
+}
+
+// validatePeering checks that a peering expression begins with an AS expression, i.e. an ASN, an
+// as-set name, AS-ANY, or a parenthesized expression, or is a peering-set name, per RFC 2622
+// section 5.6. Its brackets must also be balanced. The expression is not otherwise parsed.
+func validatePeering(attr, value string) error {
+	first, _, _ := strings.Cut(strings.TrimSpace(value), " ")
+	switch {
+	case strings.HasPrefix(first, "("), strings.EqualFold(first, "AS-ANY"), isASNName(first),
+		isSetName(first, ASSetPrefix), isSetName(first, PeeringSetPrefix):
+	default:
+		return &ValidationError{Attribute: attr, Value: value, Err: ErrInvalidPeering}
+	}
+	if !balanced(value) {
+		return &ValidationError{Attribute: attr, Value: value, Err: ErrInvalidPeering}
+	}
+	return nil
+}
+
+// dnsName matches a fully qualified DNS name, e.g. rtr1.example.net, with an optional trailing dot.
+var dnsName = regexp.MustCompile(`^(?i:[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)+\.?)$`)
+
+// validateDNSName checks that a value is a fully qualified DNS name.
+func validateDNSName(attr, value string) error {
+	if len(value) > 253 || !dnsName.MatchString(value) {
+		return &ValidationError{Attribute: attr, Value: value, Err: ErrInvalidDNSName}
+	}
+	return nil
+}
+
+// validateMbrsByRef checks that each mbrs-by-ref value is a mntner name or the keyword ANY.
+func validateMbrsByRef(values []string) []error {
+	var errs []error
+	for _, m := range values {
+		if !strings.EqualFold(m, "ANY") {
+			errs = append(errs, validateNames("mbrs-by-ref", m)...)
+		}
+	}
+	return errs
+}
+
+// validateContacts checks the admin-c, tech-c, and mnt-by values common to most objects.
+func validateContacts(adminPOC, techPOC, mntBy string) []error {
+	var errs []error
+	errs = append(errs, validateNames("admin-c", adminPOC)...)
+	errs = append(errs, validateNames("tech-c", techPOC)...)
+	errs = append(errs, validateNames("mnt-by", mntBy)...)
+	return errs
+}
+
+// validateRSMember checks that a value is a valid route-set member of the given family (4, or 0 for
+// either); see rpsl.ParseRSMember.
+func validateRSMember(attr, value string, family int) error {
+	m, err := ParseRSMember(value)
+	if err == nil && m.IsPrefix() {
+		err = checkPrefix(m.Prefix, family)
+	}
+	if err != nil {
+		return &ValidationError{Attribute: attr, Value: value, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package rpsl_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/rpsl"
+)
+
+func Test_ValidationError(t *testing.T) {
+	t.Run("with value", func(t *testing.T) {
+		t.Parallel()
+		err := &rpsl.ValidationError{Attribute: "route", Value: "192.0.2.1/24", Err: rpsl.ErrHostBits}
+		assert.EqualError(t, err, "rpsl: route: invalid value '192.0.2.1/24': prefix has host bits set")
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+	t.Run("without value", func(t *testing.T) {
+		t.Parallel()
+		err := &rpsl.ValidationError{Attribute: "origin", Err: rpsl.ErrMissingAttribute}
+		assert.EqualError(t, err, "rpsl: origin: mandatory attribute missing")
+	})
+}
+
+func Test_Validate(t *testing.T) {
+	t.Run("validator", func(t *testing.T) {
+		t.Parallel()
+		err := rpsl.Validate(&rpsl.Route{Route: rpsl.IPv4Prefix{Prefix: netip.MustParsePrefix("192.0.2.1/24")}, Origin: 65000})
+		assert.ErrorIs(t, err, rpsl.ErrHostBits)
+	})
+	t.Run("mandatory only", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, rpsl.Validate(&TestClass{TestClass: "TEST"}))
+		err := rpsl.Validate(&TestClass{Value: "value"})
+		var vErr *rpsl.ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, "test-class", vErr.Attribute)
+		assert.ErrorIs(t, err, rpsl.ErrMissingAttribute)
+	})
+	t.Run("err non struct", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorContains(t, rpsl.Validate("string"), "cannot validate string")
+	})
+	t.Run("multiple errors", func(t *testing.T) {
+		t.Parallel()
+		r := &rpsl.Route{
+			Route:    rpsl.IPv4Prefix{Prefix: netip.MustParsePrefix("2001:db8::/32")},
+			Origin:   23456,
+			AdminPOC: "1-INVALID",
+			TechPOC:  "VALID-TECH\n-INVALID",
+			MntBy:    "MNT-ONE\nANY",
+		}
+		err := rpsl.Validate(r)
+		multi, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+		attrs := []string{}
+		for _, e := range multi.Unwrap() {
+			var vErr *rpsl.ValidationError
+			require.True(t, errors.As(e, &vErr))
+			attrs = append(attrs, vErr.Attribute+"="+vErr.Value)
+		}
+		exp := []string{"route=2001:db8::/32", "origin=AS23456", "admin-c=1-INVALID", "tech-c=-INVALID", "mnt-by=ANY"}
+		assert.Equal(t, exp, attrs)
+	})
+}